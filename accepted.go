@@ -0,0 +1,58 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"net/http"
+)
+
+const (
+	// OperationStatePending is the default state of accepted operations
+	OperationStatePending = "pending"
+)
+
+// Operation holds the details of an accepted long-running operation
+type Operation struct {
+	// ID is the operation's identifier
+	ID string `json:"id"`
+
+	// State is the operation's current state, defaults to `pending`
+	State string `json:"state"`
+
+	// Location is the URL of the operation's status resource
+	Location string `json:"location"`
+}
+
+// NewHTTPAcceptedResponse this response aide is used to create a `202 - Accepted`
+// response for long-running operations. The `Location` and `Content-Location` headers
+// point at the operation's status URL, and the operation is returned as data.
+func (r *Replier) NewHTTPAcceptedResponse(w http.ResponseWriter, operation Operation, attributes ...ResponseAttributes) error {
+
+	if isEmpty(operation.ID) || isEmpty(operation.Location) {
+		return errors.New("reply/http-accepted-aide: failed operation id and location must be provided")
+	}
+
+	if isEmpty(operation.State) {
+		operation.State = OperationStatePending
+	}
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: http.StatusAccepted,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	setResponseHeader(&request, "Location", operation.Location)
+	setResponseHeader(&request, "Content-Location", operation.Location)
+
+	request.Data = operation
+
+	return r.NewHTTPResponse(&request)
+}
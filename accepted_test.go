@@ -0,0 +1,65 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPAcceptedResponse(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		operation          reply.Operation
+		expectedErr        error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Failure - Missing location",
+			operation:   reply.Operation{ID: "op-1"},
+			expectedErr: errors.New("reply/http-accepted-aide: failed operation id and location must be provided"),
+		},
+		{
+			name:               "Success - Defaults to pending",
+			operation:          reply.Operation{ID: "op-1", Location: "/operations/op-1"},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       `{"data":{"id":"op-1","state":"pending","location":"/operations/op-1"}}`,
+		},
+		{
+			name:               "Success - Custom state",
+			operation:          reply.Operation{ID: "op-1", State: "running", Location: "/operations/op-1"},
+			expectedStatusCode: http.StatusAccepted,
+			expectedBody:       `{"data":{"id":"op-1","state":"running","location":"/operations/op-1"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPAcceptedResponse(w, test.operation)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.operation.Location, w.Header().Get("Location"))
+			assert.Equal(t, test.operation.Location, w.Header().Get("Content-Location"))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"time"
+)
+
+// AuditRecord holds the structured details of a sent response passed to an
+// audit sink
+type AuditRecord struct {
+	// Method the method of the request responded to, if known
+	Method string `json:"method,omitempty"`
+
+	// Route the path of the request responded to, requires WithRequest
+	Route string `json:"route,omitempty"`
+
+	// StatusCode the status code written to the client
+	StatusCode int `json:"status_code"`
+
+	// ErrorCodes the codes of the manifest items the response's error(s)
+	// resolved to
+	ErrorCodes []string `json:"error_codes,omitempty"`
+
+	// BytesWritten the size of the response body
+	BytesWritten int `json:"bytes_written"`
+
+	// Duration how long the replier took to generate and send the response
+	Duration time.Duration `json:"duration"`
+
+	// Actor who the request was made by, see WithAuditActor
+	Actor string `json:"actor,omitempty"`
+
+	// SentAt when the response was sent
+	SentAt time.Time `json:"sent_at"`
+}
+
+// AuditSink outlines expected methods of an audit sink, used to centralise
+// the audit log of every response sent by the replier
+type AuditSink interface {
+	Audit(record AuditRecord)
+}
+
+// AuditSinkFunc is an adapter to allow the use of ordinary functions as audit
+// sinks
+type AuditSinkFunc func(record AuditRecord)
+
+// Audit calls f(record)
+func (f AuditSinkFunc) Audit(record AuditRecord) {
+	f(record)
+}
+
+// AuditActorFunc returns who the request was made by from the response request's
+// context, i.e. the authenticated user's ID
+type AuditActorFunc func(ctx context.Context) string
+
+// WithAuditSink sets the sink that receives an audit record after every
+// response is sent by the replier
+//
+// NOTE - The sink is called after the replier's other post send hooks
+func WithAuditSink(sink AuditSink) Option {
+	return func(r *Replier) {
+		r.auditSink = sink
+	}
+}
+
+// WithAuditActor sets the function used to get the actor of audit records
+func WithAuditActor(actor AuditActorFunc) Option {
+	return func(r *Replier) {
+		r.auditActor = actor
+	}
+}
+
+// audit passes the record of the sent response to the replier's audit sink
+func (r *Replier) audit(response *NewResponseRequest, startedAt time.Time) {
+	result := response.result
+
+	record := AuditRecord{
+		Method:       response.Method,
+		StatusCode:   result.StatusCode,
+		ErrorCodes:   result.ErrorCodes,
+		BytesWritten: result.BytesWritten,
+		Duration:     time.Since(startedAt),
+		SentAt:       time.Now(),
+	}
+
+	if response.Request != nil && response.Request.URL != nil {
+		record.Route = response.Request.URL.Path
+	}
+
+	if r.auditActor != nil {
+		record.Actor = r.auditActor(getResponseContext(response))
+	}
+
+	r.auditSink.Audit(record)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+type auditActorKey struct{}
+
+func TestReplier_WithAuditSink(t *testing.T) {
+
+	errUserNotFound := errors.New("user not found")
+
+	tests := []struct {
+		name           string
+		send           func(replier *reply.Replier, w http.ResponseWriter, request *http.Request) error
+		expectedRecord reply.AuditRecord
+	}{
+		{
+			name: "Success - Data response",
+			send: func(replier *reply.Replier, w http.ResponseWriter, request *http.Request) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, map[string]string{"name": "john doe"}, reply.WithRequest(request))
+			},
+			expectedRecord: reply.AuditRecord{
+				Method:       http.MethodGet,
+				Route:        "/users/1",
+				StatusCode:   http.StatusOK,
+				BytesWritten: len(stringWithNewLine(`{"data":{"name":"john doe"}}`)),
+				Actor:        "user-1",
+			},
+		},
+		{
+			name: "Success - Error response",
+			send: func(replier *reply.Replier, w http.ResponseWriter, request *http.Request) error {
+				return replier.NewHTTPErrorResponse(w, errUserNotFound, reply.WithRequest(request))
+			},
+			expectedRecord: reply.AuditRecord{
+				Method:       http.MethodGet,
+				Route:        "/users/1",
+				StatusCode:   http.StatusNotFound,
+				ErrorCodes:   []string{"USER-404"},
+				BytesWritten: len(stringWithNewLine(`{"errors":[{"title":"User Not Found","code":"USER-404","status":"404"}]}`)),
+				Actor:        "user-1",
+			},
+		},
+		{
+			name: "Success - Without request",
+			send: func(replier *reply.Replier, w http.ResponseWriter, request *http.Request) error {
+				return replier.NewHTTPBlankResponse(w, http.StatusOK)
+			},
+			expectedRecord: reply.AuditRecord{
+				StatusCode:   http.StatusOK,
+				BytesWritten: len(stringWithNewLine(`{"data":"{}"}`)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var records []reply.AuditRecord
+
+			replier := reply.NewReplier([]reply.ErrorManifest{
+				{errUserNotFound.Error(): reply.ErrorManifestItem{Title: "User Not Found", StatusCode: http.StatusNotFound, Code: "USER-404"}},
+			},
+				reply.WithAuditSink(reply.AuditSinkFunc(func(record reply.AuditRecord) {
+					records = append(records, record)
+				})),
+				reply.WithAuditActor(func(ctx context.Context) string {
+					actor, _ := ctx.Value(auditActorKey{}).(string)
+					return actor
+				}),
+			)
+
+			request := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			request = request.WithContext(context.WithValue(request.Context(), auditActorKey{}, "user-1"))
+
+			assert.NoError(t, test.send(replier, httptest.NewRecorder(), request))
+
+			assert.Len(t, records, 1)
+			record := records[0]
+			assert.False(t, record.SentAt.IsZero())
+			assert.GreaterOrEqual(t, int64(record.Duration), int64(0))
+
+			record.SentAt = test.expectedRecord.SentAt
+			record.Duration = test.expectedRecord.Duration
+			assert.Equal(t, test.expectedRecord, record)
+		})
+	}
+}
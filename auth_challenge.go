@@ -0,0 +1,89 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strings"
+)
+
+// AuthChallenge holds the authentication challenge returned in the
+// `WWW-Authenticate` header of unauthorized responses
+type AuthChallenge struct {
+	// Scheme is the authentication scheme, i.e. `Bearer` or `Basic`
+	Scheme string
+
+	Realm string
+
+	// Error, ErrorDescription and Scope are the Bearer token error
+	// attributes described in RFC 6750 section 3
+	Error            string
+	ErrorDescription string
+	Scope            string
+}
+
+// BearerChallenge returns a Bearer authentication challenge, errorCode should be
+// one of `invalid_request`, `invalid_token` or `insufficient_scope`, or empty
+// when no credentials were provided
+func BearerChallenge(realm, errorCode, errorDescription string) AuthChallenge {
+	return AuthChallenge{Scheme: "Bearer", Realm: realm, Error: errorCode, ErrorDescription: errorDescription}
+}
+
+// BasicChallenge returns a Basic authentication challenge
+func BasicChallenge(realm string) AuthChallenge {
+	return AuthChallenge{Scheme: "Basic", Realm: realm}
+}
+
+// String returns the challenge formatted as a `WWW-Authenticate` header value
+func (c AuthChallenge) String() string {
+
+	var parameters []string
+
+	for _, parameter := range []struct {
+		name  string
+		value string
+	}{
+		{"realm", c.Realm},
+		{"scope", c.Scope},
+		{"error", c.Error},
+		{"error_description", c.ErrorDescription},
+	} {
+		if isEmpty(parameter.value) {
+			continue
+		}
+		parameters = append(parameters, parameter.name+"="+quoteChallengeValue(parameter.value))
+	}
+
+	if len(parameters) == 0 {
+		return c.Scheme
+	}
+
+	return c.Scheme + " " + strings.Join(parameters, ", ")
+}
+
+// quoteChallengeValue returns the value as a quoted string
+func quoteChallengeValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// NewHTTPUnauthorizedResponse this response aide is used to create a
+// `401 - Unauthorized` error response, with the `WWW-Authenticate` header
+// holding the passed challenge
+func (r *Replier) NewHTTPUnauthorizedResponse(w http.ResponseWriter, challenge AuthChallenge, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  ErrUnauthorized,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	setResponseHeader(&request, "WWW-Authenticate", challenge.String())
+
+	return r.NewHTTPResponse(&request)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPUnauthorizedResponse(t *testing.T) {
+
+	tests := []struct {
+		name              string
+		challenge         reply.AuthChallenge
+		expectedChallenge string
+	}{
+		{
+			name:              "Success - Basic challenge",
+			challenge:         reply.BasicChallenge("example"),
+			expectedChallenge: `Basic realm="example"`,
+		},
+		{
+			name:              "Success - Bearer challenge without error",
+			challenge:         reply.BearerChallenge("example", "", ""),
+			expectedChallenge: `Bearer realm="example"`,
+		},
+		{
+			name:              "Success - Bearer challenge with error",
+			challenge:         reply.BearerChallenge("example", "invalid_token", `The "access" token expired`),
+			expectedChallenge: `Bearer realm="example", error="invalid_token", error_description="The \"access\" token expired"`,
+		},
+		{
+			name:              "Success - Scheme only",
+			challenge:         reply.AuthChallenge{Scheme: "Bearer"},
+			expectedChallenge: `Bearer`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPUnauthorizedResponse(w, test.challenge)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, test.expectedChallenge, w.Header().Get("WWW-Authenticate"))
+			assert.Equal(t, stringWithNewLine(`{"errors":[{"title":"Unauthorized","status":"401"}]}`), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ooaklee/reply"
+)
+
+// discardResponseWriter is a http.ResponseWriter that drops everything written,
+// so benchmarks measure the replier rather than the recorder
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *discardResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *discardResponseWriter) WriteHeader(statusCode int) {}
+
+func newDiscardResponseWriter() *discardResponseWriter {
+	return &discardResponseWriter{header: http.Header{}}
+}
+
+func BenchmarkReplier_NewHTTPDataResponse(b *testing.B) {
+	replier := reply.NewReplier(getEmptyErrorManifest())
+	w := newDiscardResponseWriter()
+	user := getTestUser()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = replier.NewHTTPDataResponse(w, http.StatusOK, user)
+	}
+}
+
+func BenchmarkReplier_NewHTTPErrorResponse(b *testing.B) {
+	replier := reply.NewReplier(getDefaultErrorManifest())
+	w := newDiscardResponseWriter()
+	err := getExampleErrorOne()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = replier.NewHTTPErrorResponse(w, err)
+	}
+}
+
+func BenchmarkReplier_NewHTTPMultiErrorResponse(b *testing.B) {
+	replier := reply.NewReplier(getDefaultErrorManifest())
+	w := newDiscardResponseWriter()
+	errs := getMultiErrors()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = replier.NewHTTPMultiErrorResponse(w, errs)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"sync"
+)
+
+const (
+	// maxPooledBufferSize is the largest buffer capacity returned to the pool,
+	// larger buffers are dropped so rare large responses are not retained
+	maxPooledBufferSize = 64 << 10
+)
+
+// bufferPool holds the buffers responses are encoded into before being written
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer returns an empty buffer from the pool
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer resets the buffer and returns it to the pool
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+
+	buffer.Reset()
+	bufferPool.Put(buffer)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Capture holds a snapshot of the last response rendered by a replier, see
+// WithCapture
+type Capture struct {
+	// StatusCode is the status code of the response
+	StatusCode int
+
+	// Header holds a copy of the response's headers
+	Header http.Header
+
+	// Body holds a copy of the encoded response body
+	Body []byte
+
+	// Document holds the decoded response body, nil if the body is not a JSON
+	// object
+	Document map[string]interface{}
+
+	// Data holds the decoded value under the response's data key
+	Data interface{}
+
+	// Meta holds the decoded value under the response's meta key
+	Meta map[string]interface{}
+
+	// Errors holds the decoded errors under the response's errors key
+	Errors []EnvelopeError
+}
+
+// WithCapture records a snapshot of each response the replier renders into the
+// passed capture, so tests can assert on a response's fields without comparing
+// JSON bodies. The data, meta and errors are decoded using the replier's
+// envelope keys.
+//
+// NOTE - Intended for tests, the capture is not safe for concurrent use and
+// only JSON responses are captured
+func WithCapture(capture *Capture) Option {
+	return func(r *Replier) {
+		r.capture = capture
+	}
+}
+
+// captureResponse records the response in the replier's capture, if set
+func (r *Replier) captureResponse(writer http.ResponseWriter, statusCode int, body []byte) {
+
+	if r.capture == nil {
+		return
+	}
+
+	*r.capture = Capture{
+		StatusCode: statusCode,
+		Header:     writer.Header().Clone(),
+		Body:       append([]byte(nil), body...),
+	}
+
+	if err := json.Unmarshal(body, &r.capture.Document); err != nil {
+		return
+	}
+
+	keys := r.getEnvelopeKeys()
+
+	r.capture.Data = r.capture.Document[keys.Data]
+	r.capture.Meta, _ = r.capture.Document[keys.Meta].(map[string]interface{})
+
+	if errs, ok := r.capture.Document[keys.Errors]; ok {
+		encodedErrs, _ := json.Marshal(errs)
+		_ = json.Unmarshal(encodedErrs, &r.capture.Errors)
+	}
+}
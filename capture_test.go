@@ -0,0 +1,77 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithCapture(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		options         []reply.Option
+		send            func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedCapture func(t *testing.T, capture reply.Capture)
+	}{
+		{
+			name: "Success - Data response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser(), reply.WithMeta(map[string]interface{}{"region": "eu"}), reply.WithHeaders(map[string]string{"X-Version": "1"}))
+			},
+			expectedCapture: func(t *testing.T, capture reply.Capture) {
+				assert.Equal(t, http.StatusCreated, capture.StatusCode)
+				assert.Equal(t, "1", capture.Header.Get("X-Version"))
+				assert.Equal(t, map[string]interface{}{"id": "some-id", "name": "john doe"}, capture.Data)
+				assert.Equal(t, map[string]interface{}{"region": "eu"}, capture.Meta)
+				assert.Empty(t, capture.Errors)
+			},
+		},
+		{
+			name: "Success - Multi error response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPMultiErrorResponse(w, getMultiErrors())
+			},
+			expectedCapture: func(t *testing.T, capture reply.Capture) {
+				assert.Equal(t, http.StatusBadRequest, capture.StatusCode)
+				assert.Nil(t, capture.Data)
+				assert.Equal(t, []reply.EnvelopeError{
+					{Title: "Validation Error", Detail: "Check your DoB, and try again.", Status: "400", Code: "100YT"},
+					{Title: "Validation Error", Detail: "The name provided does not meet validation requirements", About: "www.example.com/reply/validation/1011", Status: "400", Code: "1011"},
+				}, capture.Errors)
+			},
+		},
+		{
+			name:    "Success - Custom envelope keys",
+			options: []reply.Option{reply.WithEnvelopeKeys(reply.EnvelopeKeys{Data: "result", Errors: "problems"})},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+			},
+			expectedCapture: func(t *testing.T, capture reply.Capture) {
+				assert.Equal(t, http.StatusNotFound, capture.StatusCode)
+				assert.Equal(t, []reply.EnvelopeError{{Title: "Resource Not Found", Status: "404"}}, capture.Errors)
+				assert.Equal(t, stringWithNewLine(`{"problems":[{"title":"Resource Not Found","status":"404"}]}`), string(capture.Body))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var capture reply.Capture
+			replier := reply.NewReplier(getDefaultErrorManifest(), append(test.options, reply.WithCapture(&capture))...)
+
+			err := test.send(replier, httptest.NewRecorder())
+
+			assert.NoError(t, err)
+			test.expectedCapture(t, capture)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CatalogueFormat is used to define the format an error catalogue is
+// exported in
+type CatalogueFormat int
+
+const (
+	// CatalogueFormatMarkdown exports the error catalogue as a Markdown table
+	CatalogueFormatMarkdown CatalogueFormat = iota
+
+	// CatalogueFormatHTML exports the error catalogue as an HTML table
+	CatalogueFormatHTML
+)
+
+// htmlCatalogueTemplate is the template used to export error catalogues as HTML
+var htmlCatalogueTemplate = template.Must(template.New("catalogue").Parse(`<table>
+<thead><tr><th>Key</th><th>Code</th><th>Status</th><th>Title</th><th>Detail</th><th>About</th></tr></thead>
+<tbody>
+{{- range .}}
+<tr><td>{{.Key}}</td><td>{{.Code}}</td><td>{{.StatusCode}}</td><td>{{.Title}}</td><td>{{.Detail}}</td><td>{{if .About}}<a href="{{.About}}">{{.About}}</a>{{end}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+`))
+
+// ErrorCatalogueEntry holds the publicly shareable attributes of a manifest item
+type ErrorCatalogueEntry struct {
+	Key        string `json:"key"`
+	Code       string `json:"code,omitempty"`
+	StatusCode int    `json:"status"`
+	Title      string `json:"title,omitempty"`
+	Detail     string `json:"detail,omitempty"`
+	About      string `json:"about,omitempty"`
+}
+
+// ErrorCatalogue returns an entry for every item in the replier's error manifest,
+// ordered by key. Status codes are resolved as they would be in a response.
+func (r *Replier) ErrorCatalogue() []ErrorCatalogueEntry {
+
+	entries := []ErrorCatalogueEntry{}
+
+	for _, key := range getSortedManifestKeys(r.errorManifest) {
+		item := r.errorManifest[key]
+
+		entries = append(entries, ErrorCatalogueEntry{
+			Key:        key,
+			Code:       item.Code,
+			StatusCode: item.StatusCode,
+			Title:      item.Title,
+			Detail:     item.Detail,
+			About:      item.About,
+		})
+	}
+
+	return entries
+}
+
+// ErrorCatalogueHandler returns a handler serving the replier's error catalogue
+// as a data response, so client teams can discover the errors returned by a
+// running service
+//
+// NOTE - Requests using methods other than `GET` and `HEAD` receive a `405 -
+// Method Not Allowed` error response
+func (r *Replier) ErrorCatalogueHandler() http.Handler {
+	entries := r.ErrorCatalogue()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			_ = r.NewHTTPMethodNotAllowedResponse(w, []string{http.MethodGet, http.MethodHead}, WithRequest(req))
+			return
+		}
+
+		_ = r.NewHTTPDataResponse(w, http.StatusOK, entries, WithRequest(req))
+	})
+}
+
+// ExportErrorCatalogue returns a human-readable table of the manifests' error keys,
+// codes, statuses, titles, details and about links in the passed format, i.e.
+// for publishing to a developer portal
+func ExportErrorCatalogue(manifests []ErrorManifest, format CatalogueFormat, options ...Option) (string, error) {
+
+	entries := NewReplier(manifests, options...).ErrorCatalogue()
+
+	switch format {
+	case CatalogueFormatMarkdown:
+		return exportMarkdownCatalogue(entries), nil
+	case CatalogueFormatHTML:
+		var catalogue bytes.Buffer
+		if err := htmlCatalogueTemplate.Execute(&catalogue, entries); err != nil {
+			return "", fmt.Errorf("reply/catalogue: failed to export catalogue with %v", err)
+		}
+		return catalogue.String(), nil
+	}
+
+	return "", fmt.Errorf("reply/catalogue: failed to export catalogue, unsupported format %d", format)
+}
+
+// exportMarkdownCatalogue returns the entries as a Markdown table
+func exportMarkdownCatalogue(entries []ErrorCatalogueEntry) string {
+
+	var catalogue strings.Builder
+
+	catalogue.WriteString("| Key | Code | Status | Title | Detail | About |\n")
+	catalogue.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+
+	for _, entry := range entries {
+		about := ""
+		if !isEmpty(entry.About) {
+			about = "[" + escapeMarkdownCell(entry.About) + "](" + entry.About + ")"
+		}
+
+		cells := []string{
+			"`" + escapeMarkdownCell(entry.Key) + "`",
+			escapeMarkdownCell(entry.Code),
+			strconv.Itoa(entry.StatusCode),
+			escapeMarkdownCell(entry.Title),
+			escapeMarkdownCell(entry.Detail),
+			about,
+		}
+
+		catalogue.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	return catalogue.String()
+}
+
+// escapeMarkdownCell escapes the characters that would break a Markdown table cell
+func escapeMarkdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ", "\r", "").Replace(s)
+}
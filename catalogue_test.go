@@ -0,0 +1,105 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportErrorCatalogue(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-pipe-error": reply.ErrorManifestItem{Title: "A | B", Detail: "<b>bold</b>"},
+	})
+
+	tests := []struct {
+		name              string
+		format            reply.CatalogueFormat
+		expectedCatalogue string
+		expectErr         bool
+	}{
+		{
+			name:   "Success - Markdown catalogue",
+			format: reply.CatalogueFormatMarkdown,
+			expectedCatalogue: "| Key | Code | Status | Title | Detail | About |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| `example-404-error` |  | 404 | Resource Not Found |  |  |\n" +
+				"| `example-dob-validation-error` | 100YT | 400 | Validation Error | Check your DoB, and try again. |  |\n" +
+				"| `example-name-validation-error` | 1011 | 400 | Validation Error | The name provided does not meet validation requirements | [www.example.com/reply/validation/1011](www.example.com/reply/validation/1011) |\n" +
+				"| `example-pipe-error` |  | 400 | A \\| B | <b>bold</b> |  |\n",
+		},
+		{
+			name:   "Success - HTML catalogue",
+			format: reply.CatalogueFormatHTML,
+			expectedCatalogue: "<table>\n<thead><tr><th>Key</th><th>Code</th><th>Status</th><th>Title</th><th>Detail</th><th>About</th></tr></thead>\n<tbody>\n" +
+				"<tr><td>example-404-error</td><td></td><td>404</td><td>Resource Not Found</td><td></td><td></td></tr>\n" +
+				"<tr><td>example-dob-validation-error</td><td>100YT</td><td>400</td><td>Validation Error</td><td>Check your DoB, and try again.</td><td></td></tr>\n" +
+				"<tr><td>example-name-validation-error</td><td>1011</td><td>400</td><td>Validation Error</td><td>The name provided does not meet validation requirements</td><td><a href=\"www.example.com/reply/validation/1011\">www.example.com/reply/validation/1011</a></td></tr>\n" +
+				"<tr><td>example-pipe-error</td><td></td><td>400</td><td>A | B</td><td>&lt;b&gt;bold&lt;/b&gt;</td><td></td></tr>\n" +
+				"</tbody>\n</table>\n",
+		},
+		{
+			name:      "Failure - Unsupported format",
+			format:    reply.CatalogueFormat(99),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalogue, err := reply.ExportErrorCatalogue(manifests, test.format)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCatalogue, catalogue)
+		})
+	}
+}
+
+func TestReplier_ErrorCatalogueHandler(t *testing.T) {
+
+	replier := reply.NewReplier([]reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", StatusCode: http.StatusNotFound, Code: "E404", About: "https://example.com/E404"}},
+	})
+
+	tests := []struct {
+		name               string
+		method             string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Catalogue served",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":[{"key":"example-404-error","code":"E404","status":404,"title":"Resource Not Found","about":"https://example.com/E404"}]}`,
+		},
+		{
+			name:               "Failure - Method not allowed",
+			method:             http.MethodPost,
+			expectedStatusCode: http.StatusMethodNotAllowed,
+			expectedBody:       `{"errors":[{"title":"Method Not Allowed","status":"405"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier.ErrorCatalogueHandler().ServeHTTP(w, httptest.NewRequest(test.method, "/errors", nil))
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"net/http"
+)
+
+// CodedError outlines an error exposing its own code, i.e. from an internal
+// library. When a passed error (or an error in its chain) implements it, and its
+// value does not have a manifest entry, its code is used to look up its manifest
+// item. Its code is also used for manifest items that do not have one.
+type CodedError interface {
+	error
+	Code() string
+}
+
+// HTTPStatusError outlines an error exposing its own HTTP status code. When a
+// passed error (or an error in its chain) implements it, and neither its value
+// nor code have a manifest entry, an item with its status code and the status
+// code's text as its title is used. Its status code is also used for manifest
+// items that do not have one.
+type HTTPStatusError interface {
+	error
+	HTTPStatus() int
+}
+
+// getCodedErrorManifestItem returns the manifest item for the code or HTTP status
+// code exposed by the error (or an error in its chain), and whether one was found
+func (r *Replier) getCodedErrorManifestItem(err error, response *NewResponseRequest) (ErrorManifestItem, bool) {
+
+	var codedErr CodedError
+	if errors.As(err, &codedErr) && !isEmpty(codedErr.Code()) {
+		if manifestItem, ok := r.lookupResponseErrorManifestItem(codedErr.Code(), response); ok {
+			return manifestItem, true
+		}
+	}
+
+	var statusErr HTTPStatusError
+	if errors.As(err, &statusErr) && isValidStatusCode(statusErr.HTTPStatus()) {
+		return ErrorManifestItem{Title: http.StatusText(statusErr.HTTPStatus()), StatusCode: statusErr.HTTPStatus()}, true
+	}
+
+	return ErrorManifestItem{}, false
+}
+
+// applyCodedError returns the manifest item with the code and HTTP status code
+// exposed by the error (or an error in its chain) set, if the item does not
+// have them. Status codes set by the replier's status code policies or default
+// error status code are replaced.
+func applyCodedError(item ErrorManifestItem, err error) ErrorManifestItem {
+
+	var codedErr CodedError
+	if isEmpty(item.Code) && errors.As(err, &codedErr) {
+		item.Code = codedErr.Code()
+	}
+
+	var statusErr HTTPStatusError
+	if (item.StatusCode == 0 || item.statusCodeDefaulted) && errors.As(err, &statusErr) && isValidStatusCode(statusErr.HTTPStatus()) {
+		item.StatusCode = statusErr.HTTPStatus()
+	}
+
+	item.statusCodeDefaulted = false
+
+	return item
+}
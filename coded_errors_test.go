@@ -0,0 +1,116 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// libraryError mirrors the coded errors exposed by internal libraries
+type libraryError struct {
+	message    string
+	code       string
+	statusCode int
+}
+
+// Error returns the error's message
+func (e *libraryError) Error() string {
+	return e.message
+}
+
+// Code returns the error's code
+func (e *libraryError) Code() string {
+	return e.code
+}
+
+// HTTPStatus returns the error's HTTP status code
+func (e *libraryError) HTTPStatus() int {
+	return e.statusCode
+}
+
+func TestReplier_CodedErrors(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"ACC-404": reply.ErrorManifestItem{Title: "Account Not Found", StatusCode: http.StatusNotFound}},
+		{"account is locked": reply.ErrorManifestItem{Title: "Account Locked", StatusCode: http.StatusForbidden}},
+		{"account is closed": reply.ErrorManifestItem{Title: "Account Closed", Code: "C001", StatusCode: http.StatusGone}},
+		{"account is busy": reply.ErrorManifestItem{Title: "Account Busy"}},
+	}
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Code used to look up manifest item",
+			err:                &libraryError{message: "account 123 not found", code: "ACC-404", statusCode: http.StatusTeapot},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"Account Not Found","status":"404","code":"ACC-404"}]}`,
+		},
+		{
+			name:               "Failure - Unmatched code without HTTP status uses fallback",
+			err:                fmt.Errorf("failed to charge: %w", &libraryError{message: "account is frozen", code: "ACC-403"}),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+		{
+			name:               "Success - Value takes precedence over code, with code added to item",
+			err:                &libraryError{message: "account is locked", code: "ACC-404"},
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `{"errors":[{"title":"Account Locked","status":"403","code":"ACC-404"}]}`,
+		},
+		{
+			name:               "Success - Manifest item code kept",
+			err:                &libraryError{message: "account is closed", code: "ACC-410"},
+			expectedStatusCode: http.StatusGone,
+			expectedBody:       `{"errors":[{"title":"Account Closed","status":"410","code":"C001"}]}`,
+		},
+		{
+			name:               "Success - HTTP status used for manifest item without status",
+			err:                &libraryError{message: "account is busy", code: "ACC-409", statusCode: http.StatusConflict},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"errors":[{"title":"Account Busy","status":"409","code":"ACC-409"}]}`,
+		},
+		{
+			name:               "Success - Default status used for manifest item without status or HTTP status",
+			err:                &libraryError{message: "account is busy", code: "ACC-409"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Account Busy","status":"400","code":"ACC-409"}]}`,
+		},
+		{
+			name:               "Success - HTTP status used without manifest entry",
+			err:                fmt.Errorf("failed to charge: %w", &libraryError{message: "rate limited", code: "ACC-429", statusCode: http.StatusTooManyRequests}),
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedBody:       `{"errors":[{"title":"Too Many Requests","status":"429","code":"ACC-429"}]}`,
+		},
+		{
+			name:               "Failure - Invalid HTTP status uses fallback",
+			err:                &libraryError{message: "rate limited", code: "ACC-429", statusCode: 1000},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(manifests)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"os"
+)
+
+// CommonErrorsManifest returns a manifest mapping common standard library errors
+// to responses, to be merged with a service's own manifests, i.e.
+//
+//	reply.NewReplier(append([]reply.ErrorManifest{reply.CommonErrorsManifest()}, manifests...))
+//
+// It maps:
+//
+// - `sql.ErrNoRows` and `os.ErrNotExist` to `404 - Resource Not Found`
+//
+// - `io.EOF` (an empty request body), `io.ErrUnexpectedEOF` and `encoding/json`
+// syntax and type errors to `400 - Bad Request`
+//
+// - `http: request body too large` (see `http.MaxBytesReader`) to `413 - Request Entity Too Large`
+//
+// - `context.DeadlineExceeded` to `504 - Gateway Timeout`, and `context.Canceled`
+// to `499 - Client Closed Request`
+//
+// NOTE - Items are keyed on the errors' values, so errors wrapping them (i.e.
+// with `fmt.Errorf`) are not matched
+func CommonErrorsManifest() ErrorManifest {
+	notFound := ErrorManifestItem{Title: "Resource Not Found", Detail: "The requested resource could not be found", StatusCode: http.StatusNotFound}
+	malformedBody := ErrorManifestItem{Title: "Bad Request", Detail: "The request body could not be decoded", StatusCode: http.StatusBadRequest}
+
+	return ErrorManifest{
+		sql.ErrNoRows.Error():       notFound,
+		os.ErrNotExist.Error():      notFound,
+		io.EOF.Error():              {Title: "Bad Request", Detail: "The request body is empty", StatusCode: http.StatusBadRequest},
+		io.ErrUnexpectedEOF.Error(): malformedBody,
+
+		// encoding/json syntax and type errors
+		"invalid character *":      malformedBody,
+		"json: cannot unmarshal *": malformedBody,
+		"json: unknown field *":    malformedBody,
+
+		"http: request body too large": {Title: "Request Entity Too Large", Detail: "The request body is too large", StatusCode: http.StatusRequestEntityTooLarge},
+
+		context.DeadlineExceeded.Error(): getDeadlineExceededErrorManifestItem(),
+		context.Canceled.Error():         getCanceledErrorManifestItem(),
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommonErrorsManifest(t *testing.T) {
+
+	decodeErr := json.NewDecoder(strings.NewReader(`{"name":`)).Decode(&user{})
+	syntaxErr := json.Unmarshal([]byte(`{"name":}`), &user{})
+	typeErr := json.Unmarshal([]byte(`{"name":1}`), &user{})
+
+	tests := []struct {
+		name               string
+		err                error
+		manifest           reply.ErrorManifest
+		expectedStatusCode int
+		expectedTitle      string
+	}{
+		{
+			name:               "Success - No rows mapped to not found",
+			err:                sql.ErrNoRows,
+			expectedStatusCode: http.StatusNotFound,
+			expectedTitle:      "Resource Not Found",
+		},
+		{
+			name:               "Success - Empty body mapped to bad request",
+			err:                io.EOF,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedTitle:      "Bad Request",
+		},
+		{
+			name:               "Success - Truncated body mapped to bad request",
+			err:                decodeErr,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedTitle:      "Bad Request",
+		},
+		{
+			name:               "Success - JSON syntax error mapped to bad request",
+			err:                syntaxErr,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedTitle:      "Bad Request",
+		},
+		{
+			name:               "Success - JSON type error mapped to bad request",
+			err:                typeErr,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedTitle:      "Bad Request",
+		},
+		{
+			name:               "Success - Body too large mapped to request entity too large",
+			err:                errors.New("http: request body too large"),
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedTitle:      "Request Entity Too Large",
+		},
+		{
+			name:               "Success - Deadline exceeded mapped to gateway timeout",
+			err:                context.DeadlineExceeded,
+			expectedStatusCode: http.StatusGatewayTimeout,
+			expectedTitle:      "Gateway Timeout",
+		},
+		{
+			name:               "Success - Service manifest takes precedence when merged after",
+			err:                sql.ErrNoRows,
+			manifest:           reply.ErrorManifest{sql.ErrNoRows.Error(): reply.ErrorManifestItem{Title: "User Not Found", StatusCode: http.StatusNotFound}},
+			expectedStatusCode: http.StatusNotFound,
+			expectedTitle:      "User Not Found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{reply.CommonErrorsManifest(), test.manifest})
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedTitle, replier.ResolveErrorManifestItem(test.err).Title)
+		})
+	}
+}
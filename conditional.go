@@ -0,0 +1,76 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"time"
+)
+
+// WithLastModified sets when the response's resource was last modified, sent as
+// the response's `Last-Modified` header
+func WithLastModified(lastModified time.Time) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.LastModified = lastModified
+	}
+}
+
+// WithIfModifiedSince sets the request's `If-Modified-Since` header value. When
+// the resource has not been modified since, successful responses to `GET` and
+// `HEAD` requests are sent as `304 - Not Modified` without a body
+func WithIfModifiedSince(ifModifiedSince string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.IfModifiedSince = ifModifiedSince
+	}
+}
+
+// setLastModified sets the response's `Last-Modified` header, if provided
+func setLastModified(response *NewResponseRequest) {
+	if response.LastModified.IsZero() {
+		return
+	}
+
+	response.Writer.Header().Set("Last-Modified", response.LastModified.UTC().Format(http.TimeFormat))
+}
+
+// isNotModified returns whether the response's resource has not been modified
+// since the time given in the request's `If-Modified-Since` header
+//
+// NOTE - Error responses, non-2xx responses and requests with methods other than
+// `GET` or `HEAD` are always sent in full
+func isNotModified(response *NewResponseRequest) bool {
+	if response.LastModified.IsZero() || response.IfModifiedSince == "" {
+		return false
+	}
+
+	if response.Error != nil || len(response.Errors) > 0 {
+		return false
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return false
+	}
+
+	if response.Method != "" && response.Method != http.MethodGet && response.Method != http.MethodHead {
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(response.IfModifiedSince)
+	if err != nil {
+		return false
+	}
+
+	// The header's precision is seconds, so sub-second changes are ignored
+	return !response.LastModified.Truncate(time.Second).After(ifModifiedSince)
+}
+
+// sendNotModifiedResponse sends a `304 - Not Modified` response without a body
+func sendNotModifiedResponse(response *NewResponseRequest) {
+	header := response.Writer.Header()
+	header.Del("Content-Type")
+	header.Del("Content-Length")
+
+	response.Writer.WriteHeader(http.StatusNotModified)
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithLastModified(t *testing.T) {
+
+	lastModified := time.Date(2021, time.March, 4, 10, 30, 15, 500, time.UTC)
+
+	tests := []struct {
+		name               string
+		send               func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedStatusCode int
+		expectedEmptyBody  bool
+	}{
+		{
+			name: "Success - No If-Modified-Since",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithLastModified(lastModified))
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Not modified since",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince(lastModified.Format(http.TimeFormat)),
+				)
+			},
+			expectedStatusCode: http.StatusNotModified,
+			expectedEmptyBody:  true,
+		},
+		{
+			name: "Success - Not modified since on HEAD request",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithMethod(http.MethodHead),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince(lastModified.Add(time.Hour).Format(http.TimeFormat)),
+				)
+			},
+			expectedStatusCode: http.StatusNotModified,
+			expectedEmptyBody:  true,
+		},
+		{
+			name: "Success - Modified since",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince(lastModified.Add(-time.Hour).Format(http.TimeFormat)),
+				)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Invalid If-Modified-Since is ignored",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince("yesterday"),
+				)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Non GET request sent in full",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithMethod(http.MethodPut),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince(lastModified.Format(http.TimeFormat)),
+				)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Error response sent in full",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("unknown"),
+					reply.WithLastModified(lastModified),
+					reply.WithIfModifiedSince(lastModified.Format(http.TimeFormat)),
+				)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{})
+
+			assert.NoError(t, test.send(replier, w))
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, "Thu, 04 Mar 2021 10:30:15 GMT", w.Header().Get("Last-Modified"))
+			assert.Equal(t, test.expectedEmptyBody, w.Body.Len() == 0)
+			if test.expectedEmptyBody {
+				assert.Empty(t, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
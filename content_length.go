@@ -0,0 +1,25 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// setContentLength sets the `Content-Length` header of buffered responses, so
+// clients and load balancers can size them, replacing any value already set.
+//
+// NOTE - The header is not set when the body is encoded by the writer, i.e.
+// compressed, as its length is then unknown. Streamed and chunked responses
+// are written without it
+func setContentLength(writer http.ResponseWriter, length int) {
+	header := writer.Header()
+	if header.Get("Content-Encoding") != "" || header.Get("Transfer-Encoding") != "" {
+		return
+	}
+
+	header.Set("Content-Length", strconv.Itoa(length))
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ContentLength(t *testing.T) {
+
+	tests := []struct {
+		name                  string
+		options               []reply.Option
+		send                  func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedContentLength string
+	}{
+		{
+			name: "Success - Buffered response sized",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getDataResponseBody()))),
+		},
+		{
+			name: "Success - Existing content length replaced",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne(), reply.WithHeaders(map[string]string{"Content-Length": "1"}))
+			},
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getErrorResponseForExampleErrorOne()))),
+		},
+		{
+			name:    "Success - Fallback error response sized",
+			options: []reply.Option{reply.WithMaxBodySize(16, reply.BodySizeError)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				_ = replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+				return nil
+			},
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getErrorResponseISEBody()))),
+		},
+		{
+			name: "Success - Compressed response not sized",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				w.Header().Set("Content-Encoding", "gzip")
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+		},
+		{
+			name:    "Success - Chunked response not sized",
+			options: []reply.Option{reply.WithMaxBodySize(16, reply.BodySizeChunk)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+		},
+		{
+			name: "Success - Streamed response not sized",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				rows := make(chan interface{})
+				close(rows)
+				return replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			assert.NoError(t, test.send(replier, w))
+
+			assert.Equal(t, test.expectedContentLength, w.Header().Get("Content-Length"))
+		})
+	}
+}
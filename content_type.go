@@ -0,0 +1,57 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// WithContentType sets the content type of the replier's responses, i.e.
+// `application/vnd.acme.v2+json; charset=utf-8`, instead of `application/json`
+//
+// NOTE - An empty content type is ignored
+func WithContentType(contentType string) Option {
+	return func(r *Replier) {
+		if contentType == "" {
+			return
+		}
+		r.contentType = contentType
+	}
+}
+
+// WithResponseContentType overrides the replier's content type for the generated
+// response only
+func WithResponseContentType(contentType string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.ContentType = contentType
+	}
+}
+
+// WithoutDefaultContentType skips setting the replier's content type on the
+// generated response when none is set, i.e. when it is determined by an upstream
+// proxy or the content of a streamed file.
+//
+// NOTE - The content type is also left undetected by `net/http`, unless set with
+// WithHeaders or on the writer
+func WithoutDefaultContentType() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.WithoutDefaultContentType = true
+	}
+}
+
+// setResponseContentType sets the response's content type header, if provided
+func setResponseContentType(response *NewResponseRequest) {
+	if response.ContentType == "" {
+		return
+	}
+
+	response.Writer.Header().Set("Content-Type", response.ContentType)
+}
+
+// omitContentType stops `net/http` detecting the content type of the response
+// from its body, by setting the header without any values
+func omitContentType(header http.Header) {
+	header["Content-Type"] = nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithContentType(t *testing.T) {
+
+	vendorContentType := "application/vnd.acme.v2+json; charset=utf-8"
+
+	tests := []struct {
+		name                string
+		options             []reply.Option
+		send                func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedContentType string
+	}{
+		{
+			name: "Success - Default content type",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+			expectedContentType: "application/json",
+		},
+		{
+			name:    "Success - Replier content type",
+			options: []reply.Option{reply.WithContentType(vendorContentType)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("unknown"))
+			},
+			expectedContentType: vendorContentType,
+		},
+		{
+			name:    "Success - Empty replier content type ignored",
+			options: []reply.Option{reply.WithContentType("")},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPBlankResponse(w, http.StatusOK)
+			},
+			expectedContentType: "application/json",
+		},
+		{
+			name:    "Success - Response content type overrides replier",
+			options: []reply.Option{reply.WithContentType(vendorContentType)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithResponseContentType("application/problem+json"),
+				)
+			},
+			expectedContentType: "application/problem+json",
+		},
+		{
+			name: "Success - Headers override response content type",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithResponseContentType("application/problem+json"),
+					reply.WithHeaders(map[string]string{"Content-Type": "application/merge-patch+json"}),
+				)
+			},
+			expectedContentType: "application/merge-patch+json",
+		},
+		{
+			name:    "Success - Default content type skipped",
+			options: []reply.Option{reply.WithContentType(vendorContentType)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithoutDefaultContentType())
+			},
+			expectedContentType: "",
+		},
+		{
+			name: "Success - Writer content type kept when default skipped",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				w.Header().Set("Content-Type", "image/png")
+				return replier.NewHTTPBlankResponse(w, http.StatusOK, reply.WithoutDefaultContentType())
+			},
+			expectedContentType: "image/png",
+		},
+		{
+			name: "Success - Response content type kept when default skipped",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+					reply.WithoutDefaultContentType(),
+					reply.WithResponseContentType("application/problem+json"),
+				)
+			},
+			expectedContentType: "application/problem+json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{}, test.options...)
+
+			assert.NoError(t, test.send(replier, w))
+
+			assert.Equal(t, test.expectedContentType, w.Header().Get("Content-Type"))
+		})
+	}
+}
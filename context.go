@@ -0,0 +1,138 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// contextHeader holds the context key whose value is copied into a
+// response header
+type contextHeader struct {
+	key        interface{}
+	headerName string
+}
+
+// WithContextMeta copies the value stored in the response request's context under
+// the passed key (i.e. correlation or tenant ID) into the meta of every response,
+// under the passed meta name. Responses whose context does not hold the key
+// are unaffected.
+func WithContextMeta(key interface{}, metaName string) Option {
+	return func(r *Replier) {
+		r.defaultMetaProviders = append(r.defaultMetaProviders, func(ctx context.Context) (string, interface{}) {
+			value := ctx.Value(key)
+			if value == nil {
+				return "", nil
+			}
+			return metaName, value
+		})
+	}
+}
+
+// WithContextHeader copies the value stored in the response request's context under
+// the passed key into the named header of every response, unless the header
+// is already set
+func WithContextHeader(key interface{}, headerName string) Option {
+	return func(r *Replier) {
+		r.contextHeaders = append(r.contextHeaders, contextHeader{key: key, headerName: headerName})
+	}
+}
+
+// WithContext adds the request's context to the response request, making it
+// available to hooks, meta providers and localizers.
+//
+// NOTE - If the context is already canceled when the response is generated, the
+// response will not be written
+func WithContext(ctx context.Context) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Context = ctx
+	}
+}
+
+// NewHTTPResponseWithContext handles generating and sending of an appropriate HTTP
+// response body based on response attributes, see NewHTTPResponse. The context is
+// made available to hooks, meta providers and localizers.
+//
+// NOTE - If the context is already canceled, the response is not encoded or
+// written and the context's error is returned
+func (r *Replier) NewHTTPResponseWithContext(ctx context.Context, response *NewResponseRequest) error {
+	response.Context = ctx
+
+	return r.NewHTTPResponse(response)
+}
+
+// NewHTTPMultiErrorResponseWithContext this response aide works like
+// NewHTTPMultiErrorResponse, with the passed context added to the response request
+func (r *Replier) NewHTTPMultiErrorResponseWithContext(ctx context.Context, w http.ResponseWriter, errs []error, attributes ...ResponseAttributes) error {
+	return r.NewHTTPMultiErrorResponse(w, errs, append([]ResponseAttributes{WithContext(ctx)}, attributes...)...)
+}
+
+// NewHTTPErrorResponseWithContext this response aide works like
+// NewHTTPErrorResponse, with the passed context added to the response request
+func (r *Replier) NewHTTPErrorResponseWithContext(ctx context.Context, w http.ResponseWriter, err error, attributes ...ResponseAttributes) error {
+	return r.NewHTTPErrorResponse(w, err, append([]ResponseAttributes{WithContext(ctx)}, attributes...)...)
+}
+
+// NewHTTPDataResponseWithContext this response aide works like
+// NewHTTPDataResponse, with the passed context added to the response request
+func (r *Replier) NewHTTPDataResponseWithContext(ctx context.Context, w http.ResponseWriter, statusCode int, data interface{}, attributes ...ResponseAttributes) error {
+	return r.NewHTTPDataResponse(w, statusCode, data, append([]ResponseAttributes{WithContext(ctx)}, attributes...)...)
+}
+
+// NewHTTPBlankResponseWithContext this response aide works like
+// NewHTTPBlankResponse, with the passed context added to the response request
+func (r *Replier) NewHTTPBlankResponseWithContext(ctx context.Context, w http.ResponseWriter, statusCode int, attributes ...ResponseAttributes) error {
+	return r.NewHTTPBlankResponse(w, statusCode, append([]ResponseAttributes{WithContext(ctx)}, attributes...)...)
+}
+
+// NewHTTPTokenResponseWithContext this response aide works like
+// NewHTTPTokenResponse, with the passed context added to the response request
+func (r *Replier) NewHTTPTokenResponseWithContext(ctx context.Context, w http.ResponseWriter, statusCode int, tokenOne, tokenTwo string, attributes ...ResponseAttributes) error {
+	return r.NewHTTPTokenResponse(w, statusCode, tokenOne, tokenTwo, append([]ResponseAttributes{WithContext(ctx)}, attributes...)...)
+}
+
+// setContextHeaders sets the headers whose values are copied from the response
+// request's context
+func (r *Replier) setContextHeaders(response *NewResponseRequest) {
+	ctx := getResponseContext(response)
+	for _, header := range r.contextHeaders {
+		value := ctx.Value(header.key)
+		if value == nil || response.transferObject.GetWriter().Header().Get(header.headerName) != "" {
+			continue
+		}
+
+		response.transferObject.GetWriter().Header().Set(header.headerName, fmt.Sprint(value))
+	}
+}
+
+// checkResponseContext returns an error if the response request's context has
+// already been canceled.
+//
+// NOTE - Responses are still sent once the context's deadline is exceeded, so
+// errors can be resolved to the replier's deadline exceeded manifest item
+func checkResponseContext(response *NewResponseRequest) error {
+	if response.Context == nil {
+		return nil
+	}
+
+	if err := response.Context.Err(); errors.Is(err, context.Canceled) {
+		return fmt.Errorf("reply/http-response: skipped sending response, context done with %w", err)
+	}
+
+	return nil
+}
+
+// getResponseContext returns the response request's context, or
+// `context.Background()` if one was not provided
+func getResponseContext(response *NewResponseRequest) context.Context {
+	if response == nil || response.Context == nil {
+		return context.Background()
+	}
+
+	return response.Context
+}
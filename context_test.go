@@ -0,0 +1,139 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+type tenantContextKey struct{}
+
+func TestReplier_ContextAwareResponses(t *testing.T) {
+
+	tenantMeta := func(ctx context.Context) (string, interface{}) {
+		return "tenant", ctx.Value(tenantContextKey{})
+	}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tenantCtx := context.WithValue(context.Background(), tenantContextKey{}, "acme")
+
+	tests := []struct {
+		name               string
+		send               func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedErr        error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success - Context made available to meta providers",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponseWithContext(tenantCtx, w, http.StatusOK, getTestUser())
+			},
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"meta":{"tenant":"acme"}}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Context passed with response request",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPResponseWithContext(tenantCtx, &reply.NewResponseRequest{Writer: w, Error: getExampleErrorOne()})
+			},
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","status":"404"}],"meta":{"tenant":"acme"}}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Failure - Canceled context skips writing",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponseWithContext(canceledCtx, w, getExampleErrorOne())
+			},
+			expectedErr:        context.Canceled,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Failure - Canceled context skips writing token response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPTokenResponseWithContext(canceledCtx, w, http.StatusOK, "test-token-1", "")
+			},
+			expectedErr:        context.Canceled,
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithDefaultMeta(tenantMeta))
+
+			err := test.send(replier, w)
+			if test.expectedErr != nil {
+				assert.True(t, errors.Is(err, test.expectedErr))
+				assert.Empty(t, w.Body.String())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+type correlationIDContextKey struct{}
+
+func TestReplier_WithContextMetaAndHeader(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		headers        map[string]string
+		expectedBody   string
+		expectedHeader string
+	}{
+		{
+			name:           "Success - Context value copied into meta and header",
+			ctx:            context.WithValue(context.Background(), correlationIDContextKey{}, "some-correlation-id"),
+			expectedBody:   `{"data":{"id":"some-id","name":"john doe"},"meta":{"correlation_id":"some-correlation-id"}}`,
+			expectedHeader: "some-correlation-id",
+		},
+		{
+			name:           "Success - Explicit header takes precedence",
+			ctx:            context.WithValue(context.Background(), correlationIDContextKey{}, "some-correlation-id"),
+			headers:        map[string]string{"X-Correlation-ID": "explicit-id"},
+			expectedBody:   `{"data":{"id":"some-id","name":"john doe"},"meta":{"correlation_id":"some-correlation-id"}}`,
+			expectedHeader: "explicit-id",
+		},
+		{
+			name:         "Success - Missing context value ignored",
+			ctx:          context.Background(),
+			expectedBody: getDataResponseBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(),
+				reply.WithContextMeta(correlationIDContextKey{}, "correlation_id"),
+				reply.WithContextHeader(correlationIDContextKey{}, "X-Correlation-ID"),
+			)
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithContext(test.ctx), reply.WithHeaders(test.headers))
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.expectedHeader, w.Header().Get("X-Correlation-ID"))
+		})
+	}
+}
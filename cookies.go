@@ -0,0 +1,81 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// CookieOptions holds the attributes of the cookies tokens are delivered in.
+// Cookies are always `HttpOnly`.
+type CookieOptions struct {
+	// AccessTokenName and RefreshTokenName are the names of the access and
+	// refresh token cookies. Defaults to `access_token` and `refresh_token`,
+	// named tokens use their own name.
+	AccessTokenName  string
+	RefreshTokenName string
+
+	Path   string
+	Domain string
+
+	// MaxAge is the cookies' max age in seconds, zero creates session cookies
+	MaxAge int
+
+	// Insecure removes the `Secure` attribute, i.e. for local development
+	// over plain HTTP
+	Insecure bool
+
+	// SameSite defaults to `SameSite=Lax`
+	SameSite http.SameSite
+}
+
+// WithTokensAsCookies delivers the tokens of token responses as cookies, omitting
+// them from the response body, to support browser-based authentication flows
+func WithTokensAsCookies(options CookieOptions) Option {
+	return func(r *Replier) {
+		r.tokenCookieOptions = &options
+	}
+}
+
+// setTokenCookies sets a cookie for each of the response request's tokens
+func (r *Replier) setTokenCookies(response *NewResponseRequest) {
+
+	options := *r.tokenCookieOptions
+
+	if isEmpty(options.AccessTokenName) {
+		options.AccessTokenName = accessTokenName
+	}
+
+	if isEmpty(options.RefreshTokenName) {
+		options.RefreshTokenName = refreshTokenName
+	}
+
+	if options.SameSite == 0 {
+		options.SameSite = http.SameSiteLaxMode
+	}
+
+	tokens := getResponseTokens(response)
+
+	for _, name := range getSortedTokenNames(tokens) {
+		cookieName := name
+		switch name {
+		case accessTokenName:
+			cookieName = options.AccessTokenName
+		case refreshTokenName:
+			cookieName = options.RefreshTokenName
+		}
+
+		http.SetCookie(response.transferObject.GetWriter(), &http.Cookie{
+			Name:     cookieName,
+			Value:    tokens[name],
+			Path:     options.Path,
+			Domain:   options.Domain,
+			MaxAge:   options.MaxAge,
+			Secure:   !options.Insecure,
+			HttpOnly: true,
+			SameSite: options.SameSite,
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithTokensAsCookies(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		options         reply.CookieOptions
+		send            func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedCookies []string
+	}{
+		{
+			name:    "Success - Default cookie attributes",
+			options: reply.CookieOptions{},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPTokenResponse(w, http.StatusOK, "access", "refresh")
+			},
+			expectedCookies: []string{
+				"access_token=access; HttpOnly; Secure; SameSite=Lax",
+				"refresh_token=refresh; HttpOnly; Secure; SameSite=Lax",
+			},
+		},
+		{
+			name:    "Success - Custom cookie attributes",
+			options: reply.CookieOptions{AccessTokenName: "session", Path: "/", MaxAge: 3600, Insecure: true, SameSite: http.SameSiteStrictMode},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPTokensResponse(w, http.StatusOK, map[string]string{"access_token": "access", "id_token": "id"})
+			},
+			expectedCookies: []string{
+				"session=access; Path=/; Max-Age=3600; HttpOnly; SameSite=Strict",
+				"id_token=id; Path=/; Max-Age=3600; HttpOnly; SameSite=Strict",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithTokensAsCookies(test.options))
+
+			err := test.send(replier, w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCookies, w.Header().Values("Set-Cookie"))
+			assert.Equal(t, stringWithNewLine(getBlankResponseBody()), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CORSPolicy holds the cross-origin resource sharing policy applied to
+// responses, see WithCORS
+type CORSPolicy struct {
+	// Origins holds the allowed origins, `*` allows any origin unless
+	// credentials are allowed
+	Origins []string
+
+	// Methods and Headers hold the methods and request headers allowed by
+	// preflight responses
+	Methods []string
+	Headers []string
+
+	// ExposedHeaders holds the response headers made available to the client
+	ExposedHeaders []string
+
+	// MaxAge is the number of seconds preflight responses can be cached for,
+	// zero leaves the header unset
+	MaxAge int
+
+	// AllowCredentials allows cookies and authorization headers to be sent.
+	//
+	// NOTE - Only explicitly listed origins are allowed when credentials are
+	// allowed, a `*` origin is ignored
+	AllowCredentials bool
+}
+
+// WithCORS sets the CORS policy applied to every response sent with the
+// origin of the request, see WithOrigin
+func WithCORS(policy CORSPolicy) Option {
+	return func(r *Replier) {
+		r.corsPolicy = &policy
+	}
+}
+
+// WithOrigin sets the `Origin` header value of the request being responded to,
+// used to apply the replier's CORS policy
+func WithOrigin(origin string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Origin = origin
+	}
+}
+
+// NewHTTPPreflightResponse this response aide is used to respond to CORS preflight
+// (`OPTIONS`) requests using the replier's CORS policy. A `204 - No Content`
+// response is written without a body.
+//
+// NOTE - If the origin is not allowed by the policy, no CORS headers are set
+func (r *Replier) NewHTTPPreflightResponse(w http.ResponseWriter, origin string, attributes ...ResponseAttributes) error {
+
+	if w == nil {
+		return fmt.Errorf("reply/http-preflight-aide: failed to send response, %w", ErrNoWriter)
+	}
+
+	if r.corsPolicy == nil {
+		return errors.New("reply/http-preflight-aide: failed to send response, no CORS policy configured")
+	}
+
+	request := NewResponseRequest{
+		Writer: w,
+		Origin: origin,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	writeResponseHeaders(w.Header(), &request)
+
+	if r.setCORSHeaders(w, request.Origin) {
+		if len(r.corsPolicy.Methods) > 0 {
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(r.corsPolicy.Methods, ", "))
+		}
+
+		if len(r.corsPolicy.Headers) > 0 {
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(r.corsPolicy.Headers, ", "))
+		}
+
+		if r.corsPolicy.MaxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(r.corsPolicy.MaxAge))
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+// setCORSHeaders sets the CORS headers shared by actual and preflight responses,
+// returning whether the origin is allowed by the replier's policy
+func (r *Replier) setCORSHeaders(w http.ResponseWriter, origin string) bool {
+
+	if r.corsPolicy == nil || isEmpty(origin) {
+		return false
+	}
+
+	addVaryHeader(w, "Origin")
+
+	allowedOrigin, ok := r.corsPolicy.getAllowedOrigin(origin)
+	if !ok {
+		return false
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
+
+	if r.corsPolicy.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+
+	if len(r.corsPolicy.ExposedHeaders) > 0 {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(r.corsPolicy.ExposedHeaders, ", "))
+	}
+
+	return true
+}
+
+// getAllowedOrigin returns the `Access-Control-Allow-Origin` header value for
+// the origin, and whether it is allowed.
+//
+// NOTE - A `*` origin is ignored when credentials are allowed, as echoing any
+// origin with credentials would let every site make credentialed requests
+func (p *CORSPolicy) getAllowedOrigin(origin string) (string, bool) {
+	for _, allowedOrigin := range p.Origins {
+		if allowedOrigin == "*" {
+			if p.AllowCredentials {
+				continue
+			}
+			return "*", true
+		}
+
+		if strings.EqualFold(allowedOrigin, origin) {
+			return origin, true
+		}
+	}
+
+	return "", false
+}
+
+// addVaryHeader adds the header name to the `Vary` header, if not already listed
+func addVaryHeader(w http.ResponseWriter, headerName string) {
+	for _, value := range w.Header().Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(name), headerName) {
+				return
+			}
+		}
+	}
+
+	w.Header().Add("Vary", headerName)
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithCORS(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		policy          reply.CORSPolicy
+		origin          string
+		expectedHeaders map[string]string
+	}{
+		{
+			name:   "Success - Allowed origin",
+			policy: reply.CORSPolicy{Origins: []string{"https://example.com"}, ExposedHeaders: []string{"X-Request-ID"}},
+			origin: "https://example.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "https://example.com",
+				"Access-Control-Expose-Headers": "X-Request-ID",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			name:   "Success - Wildcard origin",
+			policy: reply.CORSPolicy{Origins: []string{"*"}},
+			origin: "https://example.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "*",
+				"Vary":                        "Origin",
+			},
+		},
+		{
+			name:   "Success - Wildcard origin ignored with credentials",
+			policy: reply.CORSPolicy{Origins: []string{"*"}, AllowCredentials: true},
+			origin: "https://example.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "",
+				"Access-Control-Allow-Credentials": "",
+				"Vary":                             "Origin",
+			},
+		},
+		{
+			name:   "Success - Listed origin allowed with credentials alongside wildcard",
+			policy: reply.CORSPolicy{Origins: []string{"*", "https://example.com"}, AllowCredentials: true},
+			origin: "https://example.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Vary":                             "Origin",
+			},
+		},
+		{
+			name:   "Success - Disallowed origin",
+			policy: reply.CORSPolicy{Origins: []string{"https://example.com"}},
+			origin: "https://other.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"Vary":                        "Origin",
+			},
+		},
+		{
+			name:   "Success - No origin",
+			policy: reply.CORSPolicy{Origins: []string{"*"}},
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"Vary":                        "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithCORS(test.policy))
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithOrigin(test.origin))
+
+			assert.NoError(t, err)
+			for header, value := range test.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(header), header)
+			}
+		})
+	}
+}
+
+func TestReplier_NewHTTPPreflightResponse(t *testing.T) {
+
+	policy := reply.CORSPolicy{
+		Origins: []string{"https://example.com"},
+		Methods: []string{http.MethodGet, http.MethodPost},
+		Headers: []string{"Authorization", "Content-Type"},
+		MaxAge:  600,
+	}
+
+	tests := []struct {
+		name            string
+		options         []reply.Option
+		origin          string
+		expectedErr     error
+		expectedHeaders map[string]string
+	}{
+		{
+			name:        "Failure - No policy",
+			origin:      "https://example.com",
+			expectedErr: errors.New("reply/http-preflight-aide: failed to send response, no CORS policy configured"),
+		},
+		{
+			name:    "Success - Allowed origin",
+			options: []reply.Option{reply.WithCORS(policy)},
+			origin:  "https://example.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://example.com",
+				"Access-Control-Allow-Methods": "GET, POST",
+				"Access-Control-Allow-Headers": "Authorization, Content-Type",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			name:    "Success - Disallowed origin",
+			options: []reply.Option{reply.WithCORS(policy)},
+			origin:  "https://other.com",
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+
+			err := replier.NewHTTPPreflightResponse(w, test.origin)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNoContent, w.Code)
+			assert.Empty(t, w.Body.String())
+			for header, value := range test.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(header), header)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"net/http"
+)
+
+// WithResourceID adds the created resource's id, and its location as the
+// `self` link, to the meta of responses sent with NewHTTPCreatedResponse
+func WithResourceID(id string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.ResourceID = id
+	}
+}
+
+// NewHTTPCreatedResponse this response aide is used to create a `201 - Created`
+// response for newly created resources. The `Location` header points at the
+// resource's location, and the passed data is returned as data.
+//
+// With this aide, if desired, you can add the resource's id and `self` link to
+// the response's meta by using the WithResourceID optional response attribute.
+func (r *Replier) NewHTTPCreatedResponse(w http.ResponseWriter, resourceLocation string, data interface{}, attributes ...ResponseAttributes) error {
+
+	if isEmpty(resourceLocation) {
+		return errors.New("reply/http-created-aide: failed resource location must be provided")
+	}
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: http.StatusCreated,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	setResponseHeader(&request, "Location", resourceLocation)
+
+	if !isEmpty(request.ResourceID) {
+		request.Meta = mergeMeta(request.Meta, map[string]interface{}{
+			"id":   request.ResourceID,
+			"self": resourceLocation,
+		})
+	}
+
+	request.Data = data
+
+	return r.NewHTTPResponse(&request)
+}
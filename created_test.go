@@ -0,0 +1,65 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPCreatedResponse(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		resourceLocation string
+		attributes       []reply.ResponseAttributes
+		expectedErr      error
+		expectedBody     string
+	}{
+		{
+			name:        "Failure - Missing location",
+			expectedErr: errors.New("reply/http-created-aide: failed resource location must be provided"),
+		},
+		{
+			name:             "Success - Location header set",
+			resourceLocation: "/users/some-id",
+			expectedBody:     `{"data":{"id":"some-id","name":"john doe"}}`,
+		},
+		{
+			name:             "Success - Resource id and self link added to meta",
+			resourceLocation: "/users/some-id",
+			attributes: []reply.ResponseAttributes{
+				reply.WithMeta(map[string]interface{}{"region": "eu"}),
+				reply.WithResourceID("some-id"),
+			},
+			expectedBody: `{"data":{"id":"some-id","name":"john doe"},"meta":{"id":"some-id","region":"eu","self":"/users/some-id"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPCreatedResponse(w, test.resourceLocation, getTestUser(), test.attributes...)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, test.resourceLocation, w.Header().Get("Location"))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
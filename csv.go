@@ -0,0 +1,220 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const (
+	// csvContentType is the content type of CSV responses
+	csvContentType = "text/csv; charset=utf-8"
+
+	// csvTagName is the struct tag used to name CSV columns
+	csvTagName = "csv"
+)
+
+// WithCSVHeader sets the header row of the generated CSV response, overriding
+// the header derived from struct rows
+func WithCSVHeader(header []string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.CSVHeader = header
+	}
+}
+
+// WithoutCSVHeader removes the header row from the generated CSV response
+func WithoutCSVHeader() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.CSVOmitHeader = true
+	}
+}
+
+// WithFilename sets the `Content-Disposition` header so the generated response
+// is downloaded as an attachment with the passed filename
+func WithFilename(filename string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Filename = filename
+	}
+}
+
+// NewHTTPCSVResponse this response aide is used to create a CSV
+// (`text/csv`) response.
+//
+// Rows can be passed as `[][]string`, or a slice of structs (or pointers to structs).
+// For structs, each exported field is a column, named using its `csv` tag if set
+// (fields tagged `csv:"-"` are skipped), otherwise its name. Struct rows include a
+// header row by default, it can be set using WithCSVHeader or removed using
+// WithoutCSVHeader.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithFilename optional response attributes.
+//
+// NOTE - Meta is not included in CSV responses
+func (r *Replier) NewHTTPCSVResponse(w http.ResponseWriter, statusCode int, rows interface{}, attributes ...ResponseAttributes) error {
+
+	if w == nil {
+		return fmt.Errorf("reply/http-csv-aide: failed to send response, %w", ErrNoWriter)
+	}
+
+	request := NewResponseRequest{
+		Writer:        w,
+		StatusCode:    statusCode,
+		ContentType:   csvContentType,
+		UnwrappedData: true,
+		encoder:       csvEncoder{},
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	header, records, err := convertCSVRows(rows)
+	if err != nil {
+		return err
+	}
+
+	if request.CSVHeader != nil {
+		header = request.CSVHeader
+	}
+
+	if header != nil && !request.CSVOmitHeader {
+		records = append([][]string{header}, records...)
+	}
+
+	if records == nil {
+		records = [][]string{}
+	}
+	request.Data = records
+
+	if !isEmpty(request.Filename) {
+		request.Headers = setDefaultHeader(request.Headers, "Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": request.Filename}))
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// csvEncoder encodes CSV records, passed as `[][]string`
+type csvEncoder struct{}
+
+// Encode writes the records to w as CSV
+func (e csvEncoder) Encode(w io.Writer, v interface{}) error {
+	records, ok := v.([][]string)
+	if !ok {
+		return fmt.Errorf("reply/http-csv-aide: failed to write rows, unsupported type %T", v)
+	}
+
+	if err := csv.NewWriter(w).WriteAll(records); err != nil {
+		return fmt.Errorf("reply/http-csv-aide: failed to write rows with %v", err)
+	}
+
+	return nil
+}
+
+// setDefaultHeader returns a copy of the headers with the header set, unless
+// the headers already set it
+func setDefaultHeader(headers map[string]string, key, value string) map[string]string {
+	defaultedHeaders := map[string]string{key: value}
+
+	for headerKey, headerValue := range headers {
+		if http.CanonicalHeaderKey(headerKey) == http.CanonicalHeaderKey(key) {
+			delete(defaultedHeaders, key)
+		}
+		defaultedHeaders[headerKey] = headerValue
+	}
+
+	return defaultedHeaders
+}
+
+// convertCSVRows returns the header (for struct rows) and records for the
+// passed rows
+func convertCSVRows(rows interface{}) ([]string, [][]string, error) {
+
+	if stringRows, ok := rows.([][]string); ok {
+		return nil, stringRows, nil
+	}
+
+	rowsValue := reflect.ValueOf(rows)
+	if rowsValue.Kind() != reflect.Slice && rowsValue.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("reply/http-csv-aide: failed to convert rows, unsupported type %T", rows)
+	}
+
+	elemType := rowsValue.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	if elemType.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("reply/http-csv-aide: failed to convert rows, unsupported type %T", rows)
+	}
+
+	fieldIndexes, header := getCSVColumns(elemType)
+
+	records := make([][]string, 0, rowsValue.Len())
+	for i := 0; i < rowsValue.Len(); i++ {
+		rowValue := rowsValue.Index(i)
+		for rowValue.Kind() == reflect.Ptr {
+			rowValue = rowValue.Elem()
+		}
+
+		record := make([]string, len(fieldIndexes))
+		if rowValue.IsValid() {
+			for column, fieldIndex := range fieldIndexes {
+				record[column] = formatCSVValue(rowValue.Field(fieldIndex))
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return header, records, nil
+}
+
+// getCSVColumns returns the indexes and column names of the struct's exported fields
+func getCSVColumns(structType reflect.Type) ([]int, []string) {
+
+	fieldIndexes := []int{}
+	header := []string{}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get(csvTagName), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		if isEmpty(name) {
+			name = field.Name
+		}
+
+		fieldIndexes = append(fieldIndexes, i)
+		header = append(header, name)
+	}
+
+	return fieldIndexes, header
+}
+
+// formatCSVValue returns the string representation of the value, nil values
+// are represented as an empty string
+func formatCSVValue(value reflect.Value) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// csvUser mock data object with CSV tags
+type csvUser struct {
+	ID       string `csv:"id"`
+	Name     string `csv:"full_name"`
+	Age      *int   `csv:"age"`
+	Password string `csv:"-"`
+	Email    string
+	internal string
+}
+
+func TestReplier_NewHTTPCSVResponseAide(t *testing.T) {
+
+	age := 42
+
+	tests := []struct {
+		name               string
+		rows               interface{}
+		responseAttributes []reply.ResponseAttributes
+		expectedBody       string
+		expectedHeader     http.Header
+		expectErr          bool
+	}{
+		{
+			name: "Success - String rows without header",
+			rows: [][]string{{"some-id", "john doe"}, {"other-id", "jane, doe"}},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithCSVHeader([]string{"id", "name"}),
+			},
+			expectedBody:   "id,name\nsome-id,john doe\nother-id,\"jane, doe\"\n",
+			expectedHeader: http.Header{"Content-Length": []string{"46"}, "Content-Type": []string{"text/csv; charset=utf-8"}},
+		},
+		{
+			name: "Success - Struct rows with derived header and filename",
+			rows: []*csvUser{{ID: "some-id", Name: "john doe", Age: &age, Password: "secret", Email: "john@example.com"}, {ID: "other-id"}},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithFilename("users.csv"),
+			},
+			expectedBody:   "id,full_name,age,Email\nsome-id,john doe,42,john@example.com\nother-id,,,\n",
+			expectedHeader: http.Header{"Content-Length": []string{"72"}, "Content-Type": []string{"text/csv; charset=utf-8"}, "Content-Disposition": []string{`attachment; filename=users.csv`}},
+		},
+		{
+			name: "Success - Struct rows with header removed",
+			rows: []csvUser{{ID: "some-id", Name: "john doe"}},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithoutCSVHeader(),
+				reply.WithHeaders(getReplyFormattedHeader()),
+			},
+			expectedBody:   "some-id,john doe,,\n",
+			expectedHeader: http.Header{"Content-Length": []string{"19"}, "Content-Type": []string{"text/csv; charset=utf-8"}, "Correlation-Id": []string{"some-id"}},
+		},
+		{
+			name:      "Failure - Unsupported rows",
+			rows:      []int{1, 2},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPCSVResponse(w, http.StatusOK, test.rows, test.responseAttributes...)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, test.expectedBody, w.Body.String())
+			assert.Equal(t, test.expectedHeader, w.Header())
+		})
+	}
+}
+
+func TestReplier_NewHTTPCSVResponseAideSharedWritePath(t *testing.T) {
+
+	rows := [][]string{{"some-id", "john doe"}}
+
+	tests := []struct {
+		name               string
+		statusCode         int
+		responseAttributes []reply.ResponseAttributes
+		expectedErr        error
+		expectedStatusCode int
+		expectedBody       string
+		expectedLength     string
+	}{
+		{
+			name:               "Success - HEAD request body suppressed",
+			statusCode:         http.StatusOK,
+			responseAttributes: []reply.ResponseAttributes{reply.WithMethod(http.MethodHead)},
+			expectedStatusCode: http.StatusOK,
+			expectedLength:     "17",
+		},
+		{
+			name:               "Failure - Invalid status code",
+			statusCode:         42,
+			expectedErr:        &reply.InvalidStatusCodeError{StatusCode: 42},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPCSVResponse(w, test.statusCode, rows, test.responseAttributes...)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedBody, w.Body.String())
+			assert.Equal(t, test.expectedLength, w.Header().Get("Content-Length"))
+		})
+	}
+}
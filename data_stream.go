@@ -0,0 +1,145 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// NewHTTPDataStreamResponse this response aide is used to create a "successful"
+// response whose data is an array of the items received from rows. The envelope
+// is written straight away and each item encoded as it arrives, so list
+// endpoints backed by database cursors do not hold entire result sets in
+// memory. The envelope is closed, with the response's meta, once rows is closed.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - The status code is written before the first item, so the response can
+// not be replaced with an error response. If an item fails to encode, or the
+// response's context is done, the body is left incomplete and an error returned.
+func (r *Replier) NewHTTPDataStreamResponse(w http.ResponseWriter, statusCode int, rows <-chan interface{}, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: statusCode,
+		DataStream: rows,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// generateDataStreamResponse writes the items of the response's data stream as
+// they are received, between the opening and closing of the envelope
+func (r *Replier) generateDataStreamResponse(response *NewResponseRequest) error {
+
+	writer := r.newStreamWriter(response.transferObject.GetWriter())
+	defer writer.stop()
+
+	ctx := getResponseContext(response)
+	unwrapped := r.isDataUnwrapped(response)
+	keys := r.getEnvelopeKeys()
+
+	body := getBuffer()
+	defer putBuffer(body)
+
+	if !unwrapped {
+		body.WriteByte('{')
+		if err := writeDataStreamKey(body, keys.Data); err != nil {
+			return err
+		}
+	}
+	body.WriteByte('[')
+
+	writer.writer.WriteHeader(response.transferObject.GetStatusCode())
+
+	written := 0
+	for {
+		var row interface{}
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("reply/http-response: data stream interrupted, context done with %w", ctx.Err())
+		case <-writer.ticks():
+			writer.flush()
+			continue
+		case row, ok = <-response.DataStream:
+		}
+
+		if !ok {
+			break
+		}
+
+		if written > 0 {
+			body.WriteByte(',')
+		}
+		written++
+
+		if err := r.encodeDataStreamItem(body, row); err != nil {
+			return err
+		}
+
+		if err := writer.write(body.Bytes()); err != nil {
+			return err
+		}
+		body.Reset()
+	}
+
+	body.WriteByte(']')
+
+	if meta := r.buildMeta(ctx, response.Meta, response.MetaReplace); !unwrapped && len(meta) > 0 {
+		body.WriteByte(',')
+		if err := writeDataStreamKey(body, keys.Meta); err != nil {
+			return err
+		}
+
+		if err := r.encodeDataStreamItem(body, meta); err != nil {
+			return err
+		}
+	}
+
+	if !unwrapped {
+		body.WriteByte('}')
+	}
+	body.WriteByte('\n')
+
+	return writer.write(body.Bytes())
+}
+
+// encodeDataStreamItem appends the encoded item, without the encoder's trailing
+// newline, to the body
+func (r *Replier) encodeDataStreamItem(body *bytes.Buffer, item interface{}) error {
+	if err := r.encoder.Encode(body, item); err != nil {
+		return fmt.Errorf("reply/http-response: failed to encode data stream item with %w", err)
+	}
+
+	if body.Len() > 0 && body.Bytes()[body.Len()-1] == '\n' {
+		body.Truncate(body.Len() - 1)
+	}
+
+	return nil
+}
+
+// writeDataStreamKey appends the envelope key, followed by a colon, to the body
+func writeDataStreamKey(body *bytes.Buffer, key string) error {
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("reply/http-response: failed to encode envelope key with %w", err)
+	}
+
+	body.Write(encodedKey)
+	body.WriteByte(':')
+
+	return nil
+}
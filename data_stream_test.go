@@ -0,0 +1,123 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPDataStreamResponse(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		attributes         []reply.ResponseAttributes
+		rows               []interface{}
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Rows streamed in envelope",
+			rows:               []interface{}{getTestUser(), getTestUser()},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":[{"id":"some-id","name":"john doe"},{"id":"some-id","name":"john doe"}]}`,
+		},
+		{
+			name:               "Success - No rows",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":[]}`,
+		},
+		{
+			name:               "Success - Meta added after rows",
+			attributes:         []reply.ResponseAttributes{reply.WithMeta(map[string]interface{}{"page": 1})},
+			rows:               []interface{}{"a", "b"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":["a","b"],"meta":{"page":1}}`,
+		},
+		{
+			name:               "Success - Envelope keys",
+			options:            []reply.Option{reply.WithEnvelopeKeys(reply.EnvelopeKeys{Data: "result"})},
+			rows:               []interface{}{"a"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"result":["a"]}`,
+		},
+		{
+			name:               "Success - Unwrapped data",
+			attributes:         []reply.ResponseAttributes{reply.WithDataUnwrapped(), reply.WithMeta(map[string]interface{}{"page": 1})},
+			rows:               []interface{}{"a", "b"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `["a","b"]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+
+			rows := make(chan interface{})
+			go func() {
+				defer close(rows)
+				for _, row := range test.rows {
+					rows <- row
+				}
+			}()
+
+			err := replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows, test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_NewHTTPDataStreamResponseInterrupted(t *testing.T) {
+
+	t.Run("Failure - Context done", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getEmptyErrorManifest())
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		rows := make(chan interface{})
+		go func() {
+			rows <- "a"
+			cancel()
+		}()
+
+		err := replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows, reply.WithContext(ctx))
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, `{"data":["a"`, w.Body.String())
+	})
+
+	t.Run("Failure - Row fails to encode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getEmptyErrorManifest())
+
+		rows := make(chan interface{}, 2)
+		rows <- "a"
+		rows <- func() {}
+		close(rows)
+
+		err := replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows)
+
+		var unsupportedTypeErr *json.UnsupportedTypeError
+		assert.True(t, errors.As(err, &unsupportedTypeErr))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"data":["a"`, w.Body.String())
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+const (
+	// StatusClientClosedRequest is the non-standard status code used when the
+	// client closed the request before a response was sent
+	StatusClientClosedRequest = 499
+)
+
+// WithDeadlineExceededErrorItem sets the manifest item used for errors
+// that wrap `context.DeadlineExceeded` and do not have their own manifest entry
+//
+// NOTE - Defaults to `504 - Gateway Timeout`
+func WithDeadlineExceededErrorItem(item ErrorManifestItem) Option {
+	return func(r *Replier) {
+		r.deadlineExceededErrorManifestItem = item
+	}
+}
+
+// WithCanceledErrorItem sets the manifest item used for errors that wrap
+// `context.Canceled` and do not have their own manifest entry
+//
+// NOTE - Defaults to `499 - Client Closed Request`
+func WithCanceledErrorItem(item ErrorManifestItem) Option {
+	return func(r *Replier) {
+		r.canceledErrorManifestItem = item
+	}
+}
+
+// getContextErrorManifestItem returns the manifest item for errors caused by a
+// context finishing early, and whether one applies. The passed error is checked
+// first, followed by the response request's context.
+func (r *Replier) getContextErrorManifestItem(err error, ctx context.Context) (ErrorManifestItem, bool) {
+	for _, candidate := range []error{err, ctx.Err()} {
+		switch {
+		case candidate == nil:
+			continue
+		case errors.Is(candidate, context.DeadlineExceeded):
+			return r.deadlineExceededErrorManifestItem, true
+		case errors.Is(candidate, context.Canceled):
+			return r.canceledErrorManifestItem, true
+		}
+	}
+
+	return ErrorManifestItem{}, false
+}
+
+// getDeadlineExceededErrorManifestItem returns typical 504 error with text and message
+func getDeadlineExceededErrorManifestItem() ErrorManifestItem {
+	return ErrorManifestItem{Title: "Gateway Timeout", Detail: "The request did not complete in time", StatusCode: http.StatusGatewayTimeout}
+}
+
+// getCanceledErrorManifestItem returns typical 499 error with text and message
+func getCanceledErrorManifestItem() ErrorManifestItem {
+	return ErrorManifestItem{Title: "Client Closed Request", Detail: "The request was canceled", StatusCode: StatusClientClosedRequest}
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_DeadlineAwareErrorMapping(t *testing.T) {
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		options            []reply.Option
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Deadline exceeded mapped to gateway timeout",
+			manifests:          getDefaultErrorManifest(),
+			err:                fmt.Errorf("failed to query upstream: %w", context.DeadlineExceeded),
+			expectedStatusCode: http.StatusGatewayTimeout,
+			expectedBody:       `{"errors":[{"title":"Gateway Timeout","detail":"The request did not complete in time","status":"504"}]}`,
+		},
+		{
+			name:               "Success - Canceled mapped to client closed request",
+			manifests:          getDefaultErrorManifest(),
+			err:                context.Canceled,
+			expectedStatusCode: reply.StatusClientClosedRequest,
+			expectedBody:       `{"errors":[{"title":"Client Closed Request","detail":"The request was canceled","status":"499"}]}`,
+		},
+		{
+			name:      "Success - Overridden mapping",
+			manifests: getDefaultErrorManifest(),
+			options: []reply.Option{
+				reply.WithDeadlineExceededErrorItem(reply.ErrorManifestItem{Title: "Service Unavailable", Code: "TIMEOUT", StatusCode: http.StatusServiceUnavailable}),
+			},
+			err:                context.DeadlineExceeded,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedBody:       `{"errors":[{"title":"Service Unavailable","status":"503","code":"TIMEOUT"}]}`,
+		},
+		{
+			name: "Success - Manifest entry takes precedence",
+			manifests: []reply.ErrorManifest{
+				{context.DeadlineExceeded.Error(): reply.ErrorManifestItem{Title: "Request Timeout", StatusCode: http.StatusRequestTimeout}},
+			},
+			err:                context.DeadlineExceeded,
+			expectedStatusCode: http.StatusRequestTimeout,
+			expectedBody:       `{"errors":[{"title":"Request Timeout","status":"408"}]}`,
+		},
+		{
+			name:               "Success - Unrelated error uses fallback",
+			manifests:          getDefaultErrorManifest(),
+			err:                errors.New("unknown-error"),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(test.manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+
+	t.Run("Success - Expired context sends gateway timeout", func(t *testing.T) {
+		expiredCtx, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancelExpired()
+
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getDefaultErrorManifest())
+
+		err := replier.NewHTTPErrorResponse(w, expiredCtx.Err(), reply.WithContext(expiredCtx))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+		assert.Equal(t, stringWithNewLine(`{"errors":[{"title":"Gateway Timeout","detail":"The request did not complete in time","status":"504"}]}`), w.Body.String())
+
+		w = httptest.NewRecorder()
+
+		err = replier.NewHTTPErrorResponseWithContext(expiredCtx, w, errors.New("unknown-error"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	})
+
+	t.Run("Success - Done context resolves unmatched error", func(t *testing.T) {
+		replier := reply.NewReplier(getDefaultErrorManifest())
+
+		item := replier.ResolveErrorManifestItem(errors.New("unknown-error"), reply.WithContext(canceledCtx))
+
+		assert.Equal(t, reply.StatusClientClosedRequest, item.StatusCode)
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"runtime/debug"
+)
+
+const (
+	// debugMetaKey is the error meta key debug information is added under
+	debugMetaKey = "debug"
+)
+
+// debugInformation holds details about the underlying error of a 5xx error
+type debugInformation struct {
+
+	// Error the value of the error passed to the replier
+	Error string `json:"error"`
+
+	// Chain the values of the errors wrapped by the error, outermost first
+	Chain []string `json:"chain,omitempty"`
+
+	// Stack the stack trace at the time the response was generated
+	Stack string `json:"stack,omitempty"`
+
+	// Namespace the namespace of the error's manifest item
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// WithDebug sets whether the underlying error, and the errors it wraps, are added
+// to the meta of 5xx errors under the `debug` key.
+//
+// NOTE - Debug information may divulge sensitive information, it should
+// not be enabled in production environments
+func WithDebug(enabled bool) Option {
+	return func(r *Replier) {
+		r.debug = enabled
+	}
+}
+
+// WithDebugStack sets whether the stack trace is included in the debug information
+// added to 5xx errors
+func WithDebugStack(enabled bool) Option {
+	return func(r *Replier) {
+		r.debugStack = enabled
+	}
+}
+
+// WithDebugInformation enables debug information on the generated response's 5xx
+// errors, regardless of the replier's configuration. It can be used to activate
+// debugging for trusted requests, i.e. with a verified signed header.
+func WithDebugInformation() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Debug = true
+	}
+}
+
+// isDebugEnabled returns whether debug information should be added to the
+// response's 5xx errors
+func (r *Replier) isDebugEnabled(response *NewResponseRequest) bool {
+	return r.debug || (response != nil && response.Debug)
+}
+
+// buildDebugInformation returns the debug information for the passed error
+// and its manifest item
+func (r *Replier) buildDebugInformation(err error, manifestItem ErrorManifestItem) debugInformation {
+
+	information := debugInformation{Error: err.Error(), Namespace: manifestItem.Namespace}
+
+	for wrapped := errors.Unwrap(err); wrapped != nil; wrapped = errors.Unwrap(wrapped) {
+		information.Chain = append(information.Chain, wrapped.Error())
+	}
+
+	if r.debugStack {
+		information.Stack = string(debug.Stack())
+	}
+
+	return information
+}
+
+// mergeErrorMeta returns the error meta with the passed value added under the key.
+// The existing meta is copied when it is a map, otherwise it is kept under the
+// `meta` key.
+func mergeErrorMeta(meta interface{}, key string, value interface{}) interface{} {
+
+	mergedMeta := map[string]interface{}{}
+
+	switch existing := meta.(type) {
+	case nil:
+	case map[string]interface{}:
+		for existingKey, existingValue := range existing {
+			mergedMeta[existingKey] = existingValue
+		}
+	default:
+		mergedMeta["meta"] = existing
+	}
+
+	mergedMeta[key] = value
+
+	return mergedMeta
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_DebugInformation(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-upstream-error": reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway, Meta: map[string]interface{}{"retry": true}},
+	})
+
+	wrappedErr := fmt.Errorf("example-missing-error: %w", errors.New("connection refused"))
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		responseAttributes []reply.ResponseAttributes
+		err                error
+		expectedBody       string
+		assertStack        bool
+	}{
+		{
+			name:         "Success - Debug disabled by default",
+			err:          wrappedErr,
+			expectedBody: getErrorResponseISEBody(),
+		},
+		{
+			name:         "Success - Debug adds underlying error and chain to 5xx",
+			options:      []reply.Option{reply.WithDebug(true)},
+			err:          wrappedErr,
+			expectedBody: `{"errors":[{"title":"Internal Server Error","status":"500","meta":{"debug":{"error":"example-missing-error: connection refused","chain":["connection refused"]}}}]}`,
+		},
+		{
+			name:         "Success - Debug keeps existing manifest meta",
+			options:      []reply.Option{reply.WithDebug(true)},
+			err:          errors.New("example-upstream-error"),
+			expectedBody: `{"errors":[{"title":"Bad Gateway","status":"502","meta":{"debug":{"error":"example-upstream-error"},"retry":true}}]}`,
+		},
+		{
+			name:         "Success - Debug not added to 4xx",
+			options:      []reply.Option{reply.WithDebug(true)},
+			err:          getExampleErrorOne(),
+			expectedBody: getErrorResponseForExampleErrorOne(),
+		},
+		{
+			name:               "Success - Debug activated per response",
+			responseAttributes: []reply.ResponseAttributes{reply.WithDebugInformation()},
+			err:                errors.New("example-missing-error"),
+			expectedBody:       `{"errors":[{"title":"Internal Server Error","status":"500","meta":{"debug":{"error":"example-missing-error"}}}]}`,
+		},
+		{
+			name:        "Success - Debug includes stack when enabled",
+			options:     []reply.Option{reply.WithDebug(true), reply.WithDebugStack(true)},
+			err:         errors.New("example-missing-error"),
+			assertStack: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err, test.responseAttributes...)
+			assert.NoError(t, err)
+
+			if test.assertStack {
+				var body struct {
+					Errors []struct {
+						Meta struct {
+							Debug struct {
+								Stack string `json:"stack"`
+							} `json:"debug"`
+						} `json:"meta"`
+					} `json:"errors"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Contains(t, body.Errors[0].Meta.Debug.Stack, "goroutine")
+				return
+			}
+
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Envelope holds a decoded default transfer object response body, see
+// DecodeDefaultEnvelope
+type Envelope[T any] struct {
+	// Data holds the response's data, the zero value for blank responses
+	Data T
+
+	// Errors holds the response's errors
+	Errors []EnvelopeError
+
+	// Meta holds the response's meta
+	Meta map[string]interface{}
+
+	// AccessToken holds the response's access token
+	AccessToken string
+
+	// RefreshToken holds the response's refresh token
+	RefreshToken string
+
+	// Tokens holds the response's named tokens, see NewHTTPTokensResponse
+	Tokens map[string]string
+}
+
+// EnvelopeError holds a decoded default transfer object error
+type EnvelopeError struct {
+	Title  string                 `json:"title"`
+	Detail string                 `json:"detail"`
+	About  string                 `json:"about"`
+	Status string                 `json:"status"`
+	Code   string                 `json:"code"`
+	Meta   map[string]interface{} `json:"meta"`
+	Fields map[string]string      `json:"fields"`
+}
+
+// DecodeDefaultEnvelope decodes a response body rendered by the default transfer
+// object, decoding its data into T, so API consumers and tests do not need to
+// declare the envelope themselves
+func DecodeDefaultEnvelope[T any](r io.Reader) (Envelope[T], error) {
+
+	var envelope Envelope[T]
+
+	var members map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&members); err != nil {
+		return envelope, fmt.Errorf("reply/decode-envelope: failed to decode body with %v", err)
+	}
+
+	keys := defaultEnvelopeKeys
+
+	for key, value := range members {
+		var err error
+
+		switch key {
+		case keys.Data:
+			if !bytes.Equal(value, blankEnvelopeData) {
+				err = json.Unmarshal(value, &envelope.Data)
+			}
+		case keys.Errors:
+			err = json.Unmarshal(value, &envelope.Errors)
+		case keys.Meta:
+			err = json.Unmarshal(value, &envelope.Meta)
+		case keys.AccessToken:
+			err = json.Unmarshal(value, &envelope.AccessToken)
+		case keys.RefreshToken:
+			err = json.Unmarshal(value, &envelope.RefreshToken)
+		default:
+			var token string
+			if json.Unmarshal(value, &token) == nil {
+				if envelope.Tokens == nil {
+					envelope.Tokens = make(map[string]string)
+				}
+				envelope.Tokens[key] = token
+			}
+		}
+
+		if err != nil {
+			return envelope, fmt.Errorf("reply/decode-envelope: failed to decode %s with %v", key, err)
+		}
+	}
+
+	return envelope, nil
+}
+
+// blankEnvelopeData is the data of blank responses rendered with the default
+// empty data mode
+var blankEnvelopeData = []byte(`"` + defaultResponseBody + `"`)
@@ -0,0 +1,171 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// unknownFieldErrorPrefix is the prefix of the error returned by a JSON
+	// decoder disallowing unknown fields
+	unknownFieldErrorPrefix = "json: unknown field "
+
+	// rootFieldName is the field used in decoding error details for values at
+	// the root of the request body
+	rootFieldName = "body"
+)
+
+var (
+	// ErrRequestBodyEmpty is used by NewHTTPBadRequestFromDecodeError for empty
+	// request bodies, its manifest item can be replaced using the
+	// `request-body-empty` manifest key
+	ErrRequestBodyEmpty error = &defaultItemError{
+		key:  "request-body-empty",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body must not be empty", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrRequestBodyMalformed is used by NewHTTPBadRequestFromDecodeError for
+	// request bodies holding malformed JSON, its manifest item can be replaced
+	// using the `request-body-malformed` manifest key. The `{offset}` placeholder
+	// holds the position of the malformed JSON.
+	ErrRequestBodyMalformed error = &defaultItemError{
+		key:  "request-body-malformed",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body contains malformed JSON at position {offset}", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrRequestBodyTruncated is used by NewHTTPBadRequestFromDecodeError for
+	// request bodies holding incomplete JSON, its manifest item can be replaced
+	// using the `request-body-truncated` manifest key
+	ErrRequestBodyTruncated error = &defaultItemError{
+		key:  "request-body-truncated",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body contains incomplete JSON", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrRequestBodyInvalidType is used by NewHTTPBadRequestFromDecodeError for
+	// request body values of the wrong type, its manifest item can be replaced
+	// using the `request-body-invalid-type` manifest key. The `{field}`,
+	// `{expected}` and `{offset}` placeholders hold the field, the expected type
+	// and the position of the value.
+	ErrRequestBodyInvalidType error = &defaultItemError{
+		key:  "request-body-invalid-type",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body field {field} must be a {expected}", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrRequestBodyUnknownField is used by NewHTTPBadRequestFromDecodeError for
+	// request bodies holding fields that are not allowed, its manifest item can
+	// be replaced using the `request-body-unknown-field` manifest key. The
+	// `{field}` placeholder holds the unknown field.
+	ErrRequestBodyUnknownField error = &defaultItemError{
+		key:  "request-body-unknown-field",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body field {field} is not allowed", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrRequestBodyTooLarge is used by NewHTTPBadRequestFromDecodeError for
+	// request bodies exceeding the limit of an `http.MaxBytesReader`, its
+	// manifest item can be replaced using the `request-body-too-large` manifest
+	// key. The `{limit}` placeholder holds the limit in bytes.
+	ErrRequestBodyTooLarge error = &defaultItemError{
+		key:  "request-body-too-large",
+		item: ErrorManifestItem{Title: "Request Entity Too Large", Detail: "The request body must not be larger than {limit} bytes", StatusCode: http.StatusRequestEntityTooLarge},
+	}
+
+	// ErrRequestBodyInvalid is used by NewHTTPBadRequestFromDecodeError for any
+	// other decoding error, its manifest item can be replaced using the
+	// `request-body-invalid` manifest key
+	ErrRequestBodyInvalid error = &defaultItemError{
+		key:  "request-body-invalid",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request body could not be decoded", StatusCode: http.StatusBadRequest},
+	}
+)
+
+// NewHTTPBadRequestFromDecodeError this response aide is used to create an error
+// response for an error returned while decoding a JSON request body, i.e. by
+// `json.Decoder.Decode`. Rather than a generic message, the response describes
+// what was wrong with the body:
+//
+// - `*json.SyntaxError` uses ErrRequestBodyMalformed, with the position of the
+// malformed JSON, while `io.ErrUnexpectedEOF` uses ErrRequestBodyTruncated
+//
+// - `*json.UnmarshalTypeError` uses ErrRequestBodyInvalidType, with the field,
+// expected type and position of the value. The error's source references the field.
+//
+// - `*http.MaxBytesError` uses ErrRequestBodyTooLarge (413), with the limit
+//
+// - unknown fields (see `json.Decoder.DisallowUnknownFields`) use
+// ErrRequestBodyUnknownField, with the field. The error's source references the field.
+//
+// - `io.EOF` uses ErrRequestBodyEmpty, and any other error ErrRequestBodyInvalid
+//
+// The details are also added to the error's meta, i.e. `{"field":"age","expected":"int","offset":10}`.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+func (r *Replier) NewHTTPBadRequestFromDecodeError(w http.ResponseWriter, err error, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  convertDecodeError(err),
+	}
+
+	var withParams *errorWithParams
+	if errors.As(request.Error, &withParams) {
+		request.ErrorMeta = withParams.params
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// convertDecodeError returns the error describing the decoding error, holding
+// the parameters describing its cause
+func convertDecodeError(err error) error {
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var maxBytesErr *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return ErrorWithParams(ErrRequestBodyTooLarge, map[string]interface{}{"limit": maxBytesErr.Limit})
+	case errors.As(err, &syntaxErr):
+		return ErrorWithParams(ErrRequestBodyMalformed, map[string]interface{}{"offset": syntaxErr.Offset})
+	case errors.As(err, &typeErr):
+		params := map[string]interface{}{"field": typeErr.Field, "expected": typeErr.Type.String(), "offset": typeErr.Offset}
+		if isEmpty(typeErr.Field) {
+			params["field"] = rootFieldName
+		}
+
+		return ErrorWithSource(ErrorWithParams(ErrRequestBodyInvalidType, params), getDecodeErrorPointer(typeErr.Field), "")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrRequestBodyTruncated
+	case errors.Is(err, io.EOF):
+		return ErrRequestBodyEmpty
+	case err != nil && strings.HasPrefix(err.Error(), unknownFieldErrorPrefix):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), unknownFieldErrorPrefix), `"`)
+
+		return ErrorWithSource(ErrorWithParams(ErrRequestBodyUnknownField, map[string]interface{}{"field": field}), getDecodeErrorPointer(field), "")
+	}
+
+	return ErrRequestBodyInvalid
+}
+
+// getDecodeErrorPointer returns the JSON pointer of the dot separated field path
+// used by decoding errors, empty for the root of the body
+func getDecodeErrorPointer(field string) string {
+	if isEmpty(field) {
+		return ""
+	}
+
+	return "/" + strings.ReplaceAll(field, ".", "/")
+}
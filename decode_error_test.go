@@ -0,0 +1,118 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeTestUser is the request body decoded by tests
+type decodeTestUser struct {
+	Name    string `json:"name"`
+	Address struct {
+		Number int `json:"number"`
+	} `json:"address"`
+}
+
+// decodeTestBody decodes the body into a decodeTestUser, as a handler would
+func decodeTestBody(body string, limit int64) error {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	reader := http.MaxBytesReader(httptest.NewRecorder(), req.Body, limit)
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(&decodeTestUser{})
+}
+
+func TestReplier_NewHTTPBadRequestFromDecodeError(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Syntax error references its position",
+			err:                decodeTestBody(`{"name":}`, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body contains malformed JSON at position 9","status":"400","meta":{"offset":9}}]}`,
+		},
+		{
+			name:               "Success - Type error references its field",
+			err:                decodeTestBody(`{"address":{"number":"ten"}}`, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body field address.number must be a int","status":"400","meta":{"expected":"int","field":"address.number","offset":26},"source":{"pointer":"/address/number"}}]}`,
+		},
+		{
+			name:               "Success - Type error at root of body",
+			err:                decodeTestBody(`[]`, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body field body must be a reply_test.decodeTestUser","status":"400","meta":{"expected":"reply_test.decodeTestUser","field":"body","offset":1}}]}`,
+		},
+		{
+			name:               "Success - Body exceeding limit returns request entity too large",
+			err:                decodeTestBody(`{"name":"john doe"}`, 5),
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedBody:       `{"errors":[{"title":"Request Entity Too Large","detail":"The request body must not be larger than 5 bytes","status":"413","meta":{"limit":5}}]}`,
+		},
+		{
+			name:               "Success - Unknown field references its field",
+			err:                decodeTestBody(`{"age":10}`, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body field age is not allowed","status":"400","meta":{"field":"age"},"source":{"pointer":"/age"}}]}`,
+		},
+		{
+			name:               "Success - Empty body",
+			err:                decodeTestBody(``, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body must not be empty","status":"400"}]}`,
+		},
+		{
+			name:               "Success - Truncated body",
+			err:                decodeTestBody(`{"name":"jo`, 1024),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body contains incomplete JSON","status":"400"}]}`,
+		},
+		{
+			name:               "Success - Other error",
+			err:                errors.New("read failed"),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request body could not be decoded","status":"400"}]}`,
+		},
+		{
+			name: "Success - Manifest entry replaces default item",
+			manifests: []reply.ErrorManifest{
+				{"request-body-too-large": reply.ErrorManifestItem{Title: "Payload Too Large", Detail: "Keep it under {limit} bytes", Code: "B413", StatusCode: http.StatusRequestEntityTooLarge}},
+			},
+			err:                decodeTestBody(`{"name":"john doe"}`, 5),
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedBody:       `{"errors":[{"title":"Payload Too Large","detail":"Keep it under 5 bytes","status":"413","code":"B413","meta":{"limit":5}}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPBadRequestFromDecodeError(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// decodedUser is the data decoded in envelope tests
+type decodedUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestDecodeDefaultEnvelope(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	tests := []struct {
+		name             string
+		send             func(w http.ResponseWriter) error
+		expectedEnvelope reply.Envelope[decodedUser]
+	}{
+		{
+			name: "Success - Data and meta",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, decodedUser{ID: "some-id", Name: "john doe"}, reply.WithMeta(map[string]interface{}{"region": "eu"}))
+			},
+			expectedEnvelope: reply.Envelope[decodedUser]{
+				Data: decodedUser{ID: "some-id", Name: "john doe"},
+				Meta: map[string]interface{}{"region": "eu"},
+			},
+		},
+		{
+			name: "Success - Blank response",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPBlankResponse(w, http.StatusOK)
+			},
+		},
+		{
+			name: "Success - Errors",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+			},
+			expectedEnvelope: reply.Envelope[decodedUser]{
+				Errors: []reply.EnvelopeError{{Title: "Resource Not Found", Status: "404"}},
+			},
+		},
+		{
+			name: "Success - Tokens",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPTokensResponse(w, http.StatusOK, map[string]string{"access_token": "access", "refresh_token": "refresh", "id_token": "id"})
+			},
+			expectedEnvelope: reply.Envelope[decodedUser]{
+				AccessToken:  "access",
+				RefreshToken: "refresh",
+				Tokens:       map[string]string{"id_token": "id"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			assert.NoError(t, test.send(w))
+
+			envelope, err := reply.DecodeDefaultEnvelope[decodedUser](w.Body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedEnvelope, envelope)
+		})
+	}
+}
+
+func TestDecodeDefaultEnvelope_Failures(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		body        string
+		expectedErr string
+	}{
+		{
+			name:        "Failure - Invalid JSON",
+			body:        `not json`,
+			expectedErr: "reply/decode-envelope: failed to decode body with invalid character 'o' in literal null (expecting 'u')",
+		},
+		{
+			name:        "Failure - Data does not match type",
+			body:        `{"data":[1,2]}`,
+			expectedErr: "reply/decode-envelope: failed to decode data with json: cannot unmarshal array into Go value of type reply_test.decodedUser",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := reply.DecodeDefaultEnvelope[decodedUser](strings.NewReader(test.body))
+
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
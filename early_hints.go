@@ -0,0 +1,53 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SendEarlyHints sends a `103 - Early Hints` informational response with a `Link`
+// header for each of the passed links, so clients can preload resources before
+// the final response is sent. Links can be passed as complete `Link` header
+// values (i.e. `</style.css>; rel=preload; as=style`), or as URLs which are
+// sent as `<url>; rel=preload`.
+//
+// NOTE - The `Link` headers are also sent with the final response
+func (r *Replier) SendEarlyHints(w http.ResponseWriter, links []string) error {
+
+	if w == nil {
+		return fmt.Errorf("reply/http-early-hints: failed to send response, %w", ErrNoWriter)
+	}
+
+	if len(links) == 0 {
+		return errors.New("reply/http-early-hints: failed at least one link must be provided")
+	}
+
+	for _, link := range links {
+		w.Header().Add("Link", getPreloadLink(link))
+	}
+
+	w.WriteHeader(http.StatusEarlyHints)
+
+	return nil
+}
+
+// isInformational returns whether the status code is informational (1xx), other
+// than `101 - Switching Protocols` which ends the response
+func isInformational(statusCode int) bool {
+	return statusCode >= 100 && statusCode < 200 && statusCode != http.StatusSwitchingProtocols
+}
+
+// getPreloadLink returns the link as a `Link` header value, preloading bare URLs
+func getPreloadLink(link string) string {
+	if strings.HasPrefix(link, "<") {
+		return link
+	}
+
+	return "<" + link + ">; rel=preload"
+}
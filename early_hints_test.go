@@ -0,0 +1,104 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// statusHistoryResponseWriter records every status code written to it
+type statusHistoryResponseWriter struct {
+	*httptest.ResponseRecorder
+	statusCodes []int
+	links       [][]string
+}
+
+func (w *statusHistoryResponseWriter) WriteHeader(statusCode int) {
+	w.statusCodes = append(w.statusCodes, statusCode)
+	w.links = append(w.links, w.Header().Values("Link"))
+	if statusCode >= 200 {
+		w.ResponseRecorder.WriteHeader(statusCode)
+	}
+}
+
+func TestReplier_SendEarlyHints(t *testing.T) {
+
+	tests := []struct {
+		name                string
+		links               []string
+		expectedErr         error
+		expectedStatusCodes []int
+		expectedLinks       []string
+	}{
+		{
+			name:                "Success - Complete link values",
+			links:               []string{"</style.css>; rel=preload; as=style"},
+			expectedStatusCodes: []int{http.StatusEarlyHints, http.StatusOK},
+			expectedLinks:       []string{"</style.css>; rel=preload; as=style"},
+		},
+		{
+			name:                "Success - Bare links are preloaded",
+			links:               []string{"/style.css", "/app.js"},
+			expectedStatusCodes: []int{http.StatusEarlyHints, http.StatusOK},
+			expectedLinks:       []string{"</style.css>; rel=preload", "</app.js>; rel=preload"},
+		},
+		{
+			name:                "Failure - No links",
+			expectedErr:         errors.New("reply/http-early-hints: failed at least one link must be provided"),
+			expectedStatusCodes: []int{http.StatusOK},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &statusHistoryResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+			replier := reply.NewReplier([]reply.ErrorManifest{})
+
+			err := replier.SendEarlyHints(w, test.links)
+			if test.expectedErr != nil {
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, replier.NewHTTPBlankResponse(w, http.StatusOK))
+
+			assert.Equal(t, test.expectedStatusCodes, w.statusCodes)
+			if len(test.expectedLinks) > 0 {
+				assert.Equal(t, test.expectedLinks, w.links[0])
+			}
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestReplier_SendEarlyHintsNoWriter(t *testing.T) {
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	err := replier.SendEarlyHints(nil, []string{"/style.css"})
+
+	assert.ErrorIs(t, err, reply.ErrNoWriter)
+}
+
+func TestResponseWriterRecorder_PassesThroughEarlyHints(t *testing.T) {
+	w := &statusHistoryResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	recorder := reply.NewResponseWriterRecorder(w)
+
+	recorder.WriteHeader(http.StatusEarlyHints)
+
+	assert.False(t, recorder.Written())
+	assert.Equal(t, 0, recorder.StatusCode())
+
+	recorder.WriteHeader(http.StatusOK)
+
+	assert.Equal(t, http.StatusOK, recorder.StatusCode())
+	assert.Equal(t, []int{http.StatusEarlyHints, http.StatusOK}, w.statusCodes)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+)
+
+// EmptyDataMode describes how data is rendered for responses without data,
+// i.e. blank responses
+type EmptyDataMode int
+
+const (
+	// EmptyDataString renders data as the JSON string `"{}"`
+	EmptyDataString EmptyDataMode = iota
+
+	// EmptyDataObject renders data as an empty JSON object, `{}`
+	EmptyDataObject
+
+	// EmptyDataNull renders data as `null`
+	EmptyDataNull
+
+	// EmptyDataOmit leaves data out of the response body
+	EmptyDataOmit
+)
+
+// WithEmptyData sets how data is rendered for responses without data
+//
+// NOTE - Defaults to EmptyDataString, the JSON string `"{}"`, for backwards
+// compatibility
+func WithEmptyData(mode EmptyDataMode) Option {
+	return func(r *Replier) {
+		r.emptyDataMode = mode
+	}
+}
+
+// getEmptyData returns the data used for responses without data
+func (r *Replier) getEmptyData() interface{} {
+	switch r.emptyDataMode {
+	case EmptyDataObject:
+		return json.RawMessage(`{}`)
+	case EmptyDataNull:
+		return json.RawMessage(`null`)
+	case EmptyDataOmit:
+		return nil
+	default:
+		return defaultResponseBody
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithEmptyData(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		options      []reply.Option
+		expectedBody string
+	}{
+		{
+			name:         "Success - Defaults to string",
+			expectedBody: getBlankResponseBody(),
+		},
+		{
+			name:         "Success - Empty object",
+			options:      []reply.Option{reply.WithEmptyData(reply.EmptyDataObject)},
+			expectedBody: `{"data":{}}`,
+		},
+		{
+			name:         "Success - Null",
+			options:      []reply.Option{reply.WithEmptyData(reply.EmptyDataNull)},
+			expectedBody: `{"data":null}`,
+		},
+		{
+			name:         "Success - Omitted",
+			options:      []reply.Option{reply.WithEmptyData(reply.EmptyDataOmit)},
+			expectedBody: `{}`,
+		},
+		{
+			name:         "Success - Omitted keeps meta",
+			options:      []reply.Option{reply.WithEmptyData(reply.EmptyDataOmit), reply.WithDefaultMeta(reply.MetaStatic("version", "v1"))},
+			expectedBody: `{"meta":{"version":"v1"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+
+			err := replier.NewHTTPBlankResponse(w, http.StatusOK)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
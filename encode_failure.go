@@ -0,0 +1,48 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"log"
+	"net/http"
+)
+
+// sendEncodeFailureResponse sends a `500 - Internal Server Error` error response
+// in place of a response whose body failed to encode, so the client never
+// receives a truncated body. If the error response also fails to encode, a
+// plain-text body is sent instead.
+func (r *Replier) sendEncodeFailureResponse(response *NewResponseRequest, cause error) {
+
+	log.Printf("reply/http-response: failed to encode response with %v, sending fallback error response", cause)
+
+	r.sendFallbackErrorResponse(response)
+}
+
+// sendFallbackErrorResponse sends the fallback error response, `500 - Internal
+// Server Error` unless set with WithFallbackErrorManifestItem, in place of a
+// response that could not be sent. If the error response fails to encode, a
+// plain-text body is sent instead.
+func (r *Replier) sendFallbackErrorResponse(response *NewResponseRequest) {
+
+	item := r.getFallbackErrorManifestItem(response)
+	writer := response.transferObject.GetWriter()
+
+	transferObject := response.transferObject.RefreshTransferObject()
+	transferObject.SetStatusCode(item.StatusCode)
+	transferObject.SetErrors([]TransferObjectError{r.convertErrorManifestItemToTransferObjectError(item, response)})
+
+	body := getBuffer()
+	defer putBuffer(body)
+
+	if err := r.encoder.Encode(body, getTransferObjectResponseBody(transferObject)); err != nil {
+		body.Reset()
+		body.WriteString(http.StatusText(item.StatusCode) + "\n")
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	}
+
+	setContentLength(writer, body.Len())
+	writer.WriteHeader(item.StatusCode)
+	_, _ = writer.Write(body.Bytes())
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+)
+
+// Encoder outlines how transfer objects are encoded into response bodies, so
+// the standard library's JSON encoder can be swapped for a faster
+// implementation, see the `replyjsoniter` and `replysonic` packages
+type Encoder interface {
+	Encode(w io.Writer, v interface{}) error
+}
+
+// EncoderEscapeHTML outlines the optional method an encoder can implement to
+// support WithJSONEscapeHTML
+type EncoderEscapeHTML interface {
+	SetEscapeHTML(on bool)
+}
+
+// EncoderFunc allows an ordinary function to be used as an Encoder
+type EncoderFunc func(w io.Writer, v interface{}) error
+
+// Encode calls f(w, v)
+func (f EncoderFunc) Encode(w io.Writer, v interface{}) error {
+	return f(w, v)
+}
+
+// WithEncoder sets the encoder used to encode transfer objects
+//
+// NOTE - Defaults to the standard library's JSON encoder
+func WithEncoder(encoder Encoder) Option {
+	return func(r *Replier) {
+		if encoder != nil {
+			r.encoder = encoder
+		}
+	}
+}
+
+// WithJSONEscapeHTML sets whether `<`, `>` and `&` are escaped in JSON
+// responses, which otherwise corrupts about links and HTML snippets
+//
+// NOTE - Defaults to true. Custom encoders must implement EncoderEscapeHTML
+// for the option to apply
+func WithJSONEscapeHTML(on bool) Option {
+	return func(r *Replier) {
+		r.jsonEscapeHTML = &on
+	}
+}
+
+// configureEncoder applies the replier's encoding options to its encoder
+func (r *Replier) configureEncoder() {
+	if r.jsonEscapeHTML == nil {
+		return
+	}
+
+	if encoder, ok := r.encoder.(EncoderEscapeHTML); ok {
+		encoder.SetEscapeHTML(*r.jsonEscapeHTML)
+		return
+	}
+
+	log.Printf("reply/encoder: encoder %T does not support configuring HTML escaping", r.encoder)
+}
+
+// getResponseEncoder returns the encoder used for the response's body, the
+// response's own encoder if set, otherwise the replier's
+func (r *Replier) getResponseEncoder(response *NewResponseRequest) Encoder {
+	if response.encoder != nil {
+		return response.encoder
+	}
+
+	return r.encoder
+}
+
+// jsonEncoder encodes values using the standard library's JSON encoder
+type jsonEncoder struct {
+	disableEscapeHTML bool
+}
+
+// Encode writes the JSON encoding of v, followed by a newline, to w
+func (e *jsonEncoder) Encode(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(!e.disableEscapeHTML)
+
+	return encoder.Encode(v)
+}
+
+// SetEscapeHTML sets whether problematic HTML characters are escaped
+func (e *jsonEncoder) SetEscapeHTML(on bool) {
+	e.disableEscapeHTML = !on
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithEncoder(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	var encoded interface{}
+	replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithEncoder(reply.EncoderFunc(func(w io.Writer, v interface{}) error {
+		encoded = v
+		_, err := w.Write([]byte("custom"))
+		return err
+	})))
+
+	err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+
+	assert.NoError(t, err)
+	assert.NotNil(t, encoded)
+	assert.Equal(t, "custom", w.Body.String())
+}
+
+func TestReplier_WithJSONEscapeHTML(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-error": reply.ErrorManifestItem{Title: "Bad Request", About: "https://example.com/errors?a=1&b=<2>", StatusCode: http.StatusBadRequest}},
+	}
+
+	tests := []struct {
+		name         string
+		options      []reply.Option
+		expectedBody string
+	}{
+		{
+			name:         "Success - HTML escaped by default",
+			expectedBody: `{"errors":[{"title":"Bad Request","about":"https://example.com/errors?a=1\u0026b=\u003c2\u003e","status":"400"}]}`,
+		},
+		{
+			name:         "Success - HTML escaping disabled",
+			options:      []reply.Option{reply.WithJSONEscapeHTML(false)},
+			expectedBody: `{"errors":[{"title":"Bad Request","about":"https://example.com/errors?a=1&b=<2>","status":"400"}]}`,
+		},
+		{
+			name: "Success - Option ignored for unsupported encoder",
+			options: []reply.Option{
+				reply.WithJSONEscapeHTML(false),
+				reply.WithEncoder(reply.EncoderFunc(func(w io.Writer, v interface{}) error {
+					return json.NewEncoder(w).Encode(v)
+				})),
+			},
+			expectedBody: `{"errors":[{"title":"Bad Request","about":"https://example.com/errors?a=1\u0026b=\u003c2\u003e","status":"400"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, errors.New("example-error"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
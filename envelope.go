@@ -0,0 +1,203 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+)
+
+// EnvelopeKeys holds the names of the keys used by the default transfer
+// object's envelope. Keys left empty keep their default name.
+type EnvelopeKeys struct {
+	Data         string
+	Errors       string
+	Warnings     string
+	Meta         string
+	AccessToken  string
+	RefreshToken string
+}
+
+// WithEnvelopeKeys renames the keys of the default transfer object's envelope,
+// i.e. `data` to `result`, without writing a custom transfer object
+//
+// NOTE - The option is ignored when a custom transfer object is used
+func WithEnvelopeKeys(keys EnvelopeKeys) Option {
+	return func(r *Replier) {
+		r.envelopeKeys = &keys
+	}
+}
+
+// applyEnvelopeKeys replaces the default transfer object with one using the
+// configured envelope keys
+func (r *Replier) applyEnvelopeKeys() {
+	if r.envelopeKeys == nil {
+		return
+	}
+
+	if _, ok := r.transferObject.(*defaultReplyTransferObject); !ok {
+		log.Printf("reply/envelope: envelope keys ignored, transfer object %T is not the default transfer object", r.transferObject)
+		return
+	}
+
+	r.transferObject = &envelopeTransferObject{keys: getEnvelopeKeysOrDefault(*r.envelopeKeys)}
+}
+
+// defaultEnvelopeKeys holds the default transfer object's envelope keys
+var defaultEnvelopeKeys = getEnvelopeKeysOrDefault(EnvelopeKeys{})
+
+// getEnvelopeKeys returns the replier's envelope keys, or the default keys if
+// not configured
+func (r *Replier) getEnvelopeKeys() EnvelopeKeys {
+	if r.envelopeKeys == nil {
+		return defaultEnvelopeKeys
+	}
+
+	return getEnvelopeKeysOrDefault(*r.envelopeKeys)
+}
+
+// getEnvelopeKeysOrDefault returns the passed keys with any empty keys set to
+// their default name
+func getEnvelopeKeysOrDefault(keys EnvelopeKeys) EnvelopeKeys {
+	if isEmpty(keys.Data) {
+		keys.Data = "data"
+	}
+
+	if isEmpty(keys.Errors) {
+		keys.Errors = "errors"
+	}
+
+	if isEmpty(keys.Warnings) {
+		keys.Warnings = "warnings"
+	}
+
+	if isEmpty(keys.Meta) {
+		keys.Meta = "meta"
+	}
+
+	if isEmpty(keys.AccessToken) {
+		keys.AccessToken = "access_token"
+	}
+
+	if isEmpty(keys.RefreshToken) {
+		keys.RefreshToken = "refresh_token"
+	}
+
+	return keys
+}
+
+// envelopeTransferObject is the default transfer object with renamed
+// envelope keys
+type envelopeTransferObject struct {
+	defaultReplyTransferObject
+	keys EnvelopeKeys
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+func (t *envelopeTransferObject) RefreshTransferObject() TransferObject {
+	return &envelopeTransferObject{keys: t.keys}
+}
+
+// MarshalJSON renders the transfer object using its envelope keys
+func (t *envelopeTransferObject) MarshalJSON() ([]byte, error) {
+	return t.defaultReplyTransferObject.marshalJSON(t.keys)
+}
+
+// responseBody returns the value encoded as the response body
+func (t *envelopeTransferObject) responseBody() interface{} {
+	return t
+}
+
+// keyedTransferObject renders a default transfer object using the passed
+// envelope keys
+type keyedTransferObject struct {
+	transferObject *defaultReplyTransferObject
+	keys           EnvelopeKeys
+}
+
+// MarshalJSON renders the transfer object using its envelope keys
+func (t *keyedTransferObject) MarshalJSON() ([]byte, error) {
+	return t.transferObject.marshalJSON(t.keys)
+}
+
+// marshalJSON renders the transfer object using the passed envelope keys. Extra
+// tokens are added after the refresh token, ordered by name.
+func (t *defaultReplyTransferObject) marshalJSON(keys EnvelopeKeys) ([]byte, error) {
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	// Escaping is left to the encoder writing the response
+	encoder.SetEscapeHTML(false)
+
+	body.WriteByte('{')
+	written := false
+
+	writeMember := func(key string, value interface{}) error {
+		if written {
+			body.WriteByte(',')
+		}
+		written = true
+
+		if err := encoder.Encode(key); err != nil {
+			return err
+		}
+		body.Truncate(body.Len() - 1)
+		body.WriteByte(':')
+
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		body.Truncate(body.Len() - 1)
+
+		return nil
+	}
+
+	if len(t.Errors) > 0 {
+		if err := writeMember(keys.Errors, t.Errors); err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Data != nil {
+		if err := writeMember(keys.Data, t.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(t.Warnings) > 0 {
+		if err := writeMember(keys.Warnings, t.Warnings); err != nil {
+			return nil, err
+		}
+	}
+
+	if !isEmpty(t.TokenOne) {
+		if err := writeMember(keys.AccessToken, t.TokenOne); err != nil {
+			return nil, err
+		}
+	}
+
+	if !isEmpty(t.TokenTwo) {
+		if err := writeMember(keys.RefreshToken, t.TokenTwo); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range getSortedTokenNames(t.Tokens) {
+		if err := writeMember(name, t.Tokens[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(t.Meta) > 0 {
+		if err := writeMember(keys.Meta, t.Meta); err != nil {
+			return nil, err
+		}
+	}
+
+	body.WriteByte('}')
+
+	return body.Bytes(), nil
+}
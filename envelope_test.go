@@ -0,0 +1,81 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithEnvelopeKeys(t *testing.T) {
+
+	keys := reply.EnvelopeKeys{
+		Data:        "result",
+		Errors:      "problems",
+		AccessToken: "token",
+	}
+
+	tests := []struct {
+		name         string
+		options      []reply.Option
+		send         func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedBody string
+	}{
+		{
+			name: "Success - Data and meta keys",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithMeta(map[string]interface{}{"example": "<b>meta</b>"}))
+			},
+			expectedBody: `{"result":{"id":"some-id","name":"john doe"},"meta":{"example":"\u003cb\u003emeta\u003c/b\u003e"}}`,
+		},
+		{
+			name:    "Success - HTML escaping disabled",
+			options: []reply.Option{reply.WithJSONEscapeHTML(false)},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, "<b>a & b</b>")
+			},
+			expectedBody: `{"result":"<b>a & b</b>"}`,
+		},
+		{
+			name: "Success - Error key",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne())
+			},
+			expectedBody: `{"problems":[{"title":"Resource Not Found","status":"404"}]}`,
+		},
+		{
+			name: "Success - Token keys",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPTokenResponse(w, http.StatusOK, "access", "refresh")
+			},
+			expectedBody: `{"token":"access","refresh_token":"refresh"}`,
+		},
+		{
+			name:    "Success - Ignored for custom transfer object",
+			options: []reply.Option{reply.WithTransferObject(&fooReplyTransferObject{})},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+			expectedBody: `{"bar":{"data":{"id":"some-id","name":"john doe"}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), append(test.options, reply.WithEnvelopeKeys(keys))...)
+
+			err := test.send(replier, w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
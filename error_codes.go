@@ -0,0 +1,121 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrorCodeViolation holds a manifest item whose code does not satisfy the
+// replier's error code pattern
+type ErrorCodeViolation struct {
+	Key  string
+	Code string
+}
+
+// ErrorCodePolicyError is returned when manifest items do not satisfy the
+// replier's error code pattern
+type ErrorCodePolicyError struct {
+	Pattern    string
+	Violations []ErrorCodeViolation
+}
+
+// Error returns the keys of the violating manifest items
+func (e *ErrorCodePolicyError) Error() string {
+	keys := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		keys = append(keys, violation.Key)
+	}
+
+	return fmt.Sprintf("reply/manifest: found %d manifest items with codes not matching %s: %s", len(e.Violations), e.Pattern, strings.Join(keys, ", "))
+}
+
+// WithErrorCodePattern requires every manifest item to have a code matching
+// the pattern, i.e. `^[A-Z]{2}\d{4}$`. Violating items are logged when the
+// replier is created, and returned by ValidateErrorCodes.
+func WithErrorCodePattern(pattern *regexp.Regexp) Option {
+	return func(r *Replier) {
+		r.errorCodePattern = pattern
+	}
+}
+
+// ValidateErrorCodes returns an ErrorCodePolicyError listing every manifest item
+// whose code does not match the replier's error code pattern, nil if all match
+// or no pattern is set
+func (r *Replier) ValidateErrorCodes() error {
+
+	violations := r.getErrorCodeViolations()
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ErrorCodePolicyError{Pattern: r.errorCodePattern.String(), Violations: violations}
+}
+
+// ErrorCodes returns the distinct codes of the replier's manifest items in
+// alphabetical order
+func (r *Replier) ErrorCodes() []string {
+
+	seen := make(map[string]bool)
+	codes := []string{}
+
+	addCode := func(code string) {
+		if code == "" || seen[code] {
+			return
+		}
+
+		seen[code] = true
+		codes = append(codes, code)
+	}
+
+	for _, item := range r.errorManifest {
+		addCode(item.Code)
+	}
+
+	for _, regexpItem := range r.regexpErrorManifest {
+		addCode(regexpItem.Item.Code)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+// getErrorCodeViolations returns the manifest items whose code does not match
+// the replier's error code pattern, ordered by key
+func (r *Replier) getErrorCodeViolations() []ErrorCodeViolation {
+
+	if r.errorCodePattern == nil {
+		return nil
+	}
+
+	var violations []ErrorCodeViolation
+
+	for _, key := range getSortedManifestKeys(r.errorManifest) {
+		if code := r.errorManifest[key].Code; !r.errorCodePattern.MatchString(code) {
+			violations = append(violations, ErrorCodeViolation{Key: key, Code: code})
+		}
+	}
+
+	for _, regexpItem := range r.regexpErrorManifest {
+		if code := regexpItem.Item.Code; !r.errorCodePattern.MatchString(code) {
+			violations = append(violations, ErrorCodeViolation{Key: regexpItem.Pattern.String(), Code: code})
+		}
+	}
+
+	return violations
+}
+
+// validateErrorCodes logs every manifest item whose code does not match the
+// replier's error code pattern
+func (r *Replier) validateErrorCodes() {
+	for _, violation := range r.getErrorCodeViolations() {
+		log.Printf("reply/manifest: code %q for manifest item %q does not match pattern %s", violation.Code, violation.Key, r.errorCodePattern)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ValidateErrorCodes(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		options            []reply.Option
+		expectedViolations []reply.ErrorCodeViolation
+	}{
+		{
+			name: "Success - No pattern set",
+			manifests: []reply.ErrorManifest{
+				{"example-error": reply.ErrorManifestItem{Title: "Example", Code: "invalid"}},
+			},
+		},
+		{
+			name: "Success - All codes match pattern",
+			manifests: []reply.ErrorManifest{
+				{"example-error": reply.ErrorManifestItem{Title: "Example", Code: "EX0001"}},
+			},
+			options: []reply.Option{reply.WithErrorCodePattern(regexp.MustCompile(`^[A-Z]{2}\d{4}$`))},
+		},
+		{
+			name: "Failure - Invalid and missing codes",
+			manifests: []reply.ErrorManifest{
+				{
+					"example-valid-error":   reply.ErrorManifestItem{Title: "Valid", Code: "EX0001"},
+					"example-invalid-error": reply.ErrorManifestItem{Title: "Invalid", Code: "ex-1"},
+					"example-missing-error": reply.ErrorManifestItem{Title: "Missing"},
+				},
+			},
+			options: []reply.Option{
+				reply.WithErrorCodePattern(regexp.MustCompile(`^[A-Z]{2}\d{4}$`)),
+				reply.WithRegexpErrorManifest(reply.RegexpErrorManifestItem{
+					Pattern: regexp.MustCompile(`^user/(\d+)$`),
+					Item:    reply.ErrorManifestItem{Title: "User", Code: "USER"},
+				}),
+			},
+			expectedViolations: []reply.ErrorCodeViolation{
+				{Key: "example-invalid-error", Code: "ex-1"},
+				{Key: "example-missing-error", Code: ""},
+				{Key: `^user/(\d+)$`, Code: "USER"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replier := reply.NewReplier(test.manifests, test.options...)
+
+			err := replier.ValidateErrorCodes()
+
+			if test.expectedViolations == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var policyErr *reply.ErrorCodePolicyError
+			assert.True(t, errors.As(err, &policyErr))
+			assert.Equal(t, test.expectedViolations, policyErr.Violations)
+			assert.Equal(t, `reply/manifest: found 3 manifest items with codes not matching ^[A-Z]{2}\d{4}$: example-invalid-error, example-missing-error, ^user/(\d+)$`, err.Error())
+		})
+	}
+}
+
+func TestReplier_ErrorCodes(t *testing.T) {
+
+	replier := reply.NewReplier([]reply.ErrorManifest{
+		{
+			"example-b-error": reply.ErrorManifestItem{Title: "B", Code: "EX0002"},
+			"example-a-error": reply.ErrorManifestItem{Title: "A", Code: "EX0001"},
+			"example-c-error": reply.ErrorManifestItem{Title: "C", Code: "EX0001"},
+			"example-d-error": reply.ErrorManifestItem{Title: "D"},
+		},
+	})
+
+	assert.Equal(t, []string{"EX0001", "EX0002"}, replier.ErrorCodes())
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// ErrorItemBuilder builds a keyed error manifest item, see NewErrorItem
+type ErrorItemBuilder struct {
+	key  string
+	item ErrorManifestItem
+}
+
+// InvalidErrorItemError is returned when a built error manifest item is invalid
+type InvalidErrorItemError struct {
+	Key      string
+	Problems []string
+}
+
+// Error returns the key of the invalid item, with its problems
+func (e *InvalidErrorItemError) Error() string {
+	return fmt.Sprintf("reply/manifest: invalid manifest item %q: %s", e.Key, strings.Join(e.Problems, ", "))
+}
+
+// NewErrorItem returns a builder for the manifest item of the passed key, i.e.
+//
+//	reply.NewErrorItem("example-404-error").Title("Resource Not Found").Status(404).Code("E404").Build()
+//
+// Builders are less error-prone than struct literals for large manifests, and
+// their items are validated when built.
+func NewErrorItem(key string) *ErrorItemBuilder {
+	return &ErrorItemBuilder{key: key}
+}
+
+// Title sets the item's title
+func (b *ErrorItemBuilder) Title(title string) *ErrorItemBuilder {
+	b.item.Title = title
+	return b
+}
+
+// Detail sets the item's detail
+func (b *ErrorItemBuilder) Detail(detail string) *ErrorItemBuilder {
+	b.item.Detail = detail
+	return b
+}
+
+// Status sets the item's HTTP status code
+func (b *ErrorItemBuilder) Status(statusCode int) *ErrorItemBuilder {
+	b.item.StatusCode = statusCode
+	return b
+}
+
+// Code sets the item's internal application error code
+func (b *ErrorItemBuilder) Code(code string) *ErrorItemBuilder {
+	b.item.Code = code
+	return b
+}
+
+// About sets the URL that gives further insight into the error
+func (b *ErrorItemBuilder) About(about string) *ErrorItemBuilder {
+	b.item.About = about
+	return b
+}
+
+// Meta sets the item's meta
+func (b *ErrorItemBuilder) Meta(meta interface{}) *ErrorItemBuilder {
+	b.item.Meta = meta
+	return b
+}
+
+// Severity sets the item's severity
+func (b *ErrorItemBuilder) Severity(severity Severity) *ErrorItemBuilder {
+	b.item.Severity = severity
+	return b
+}
+
+// Translation adds the item's title and detail translated for the language tag
+func (b *ErrorItemBuilder) Translation(languageTag string, translation ErrorManifestItemTranslation) *ErrorItemBuilder {
+	if b.item.Translations == nil {
+		b.item.Translations = make(map[string]ErrorManifestItemTranslation)
+	}
+
+	b.item.Translations[languageTag] = translation
+	return b
+}
+
+// Item returns the built manifest item
+func (b *ErrorItemBuilder) Item() ErrorManifestItem {
+	return b.item
+}
+
+// Validate returns an InvalidErrorItemError if the item does not have a key or
+// title, or has an invalid status code, nil otherwise
+func (b *ErrorItemBuilder) Validate() error {
+
+	var problems []string
+
+	if isEmpty(b.key) {
+		problems = append(problems, "missing key")
+	}
+
+	if isEmpty(b.item.Title) {
+		problems = append(problems, "missing title")
+	}
+
+	if b.item.StatusCode != 0 && !isValidStatusCode(b.item.StatusCode) {
+		problems = append(problems, fmt.Sprintf("invalid status code %d", b.item.StatusCode))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &InvalidErrorItemError{Key: b.key, Problems: problems}
+}
+
+// Build returns a manifest holding the built item under its key
+//
+// NOTE - Invalid items are logged, see Validate
+func (b *ErrorItemBuilder) Build() ErrorManifest {
+	if err := b.Validate(); err != nil {
+		log.Print(err)
+	}
+
+	return ErrorManifest{b.key: b.item}
+}
+
+// NewErrorManifest returns a manifest holding the item of each builder, along
+// with the first invalid item or repeated key found
+func NewErrorManifest(builders ...*ErrorItemBuilder) (ErrorManifest, error) {
+
+	manifest := make(ErrorManifest, len(builders))
+
+	for _, builder := range builders {
+		if err := builder.Validate(); err != nil {
+			return manifest, err
+		}
+
+		if _, ok := manifest[builder.key]; ok {
+			return manifest, &InvalidErrorItemError{Key: builder.key, Problems: []string{"repeated key"}}
+		}
+
+		manifest[builder.key] = builder.item
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorItem(t *testing.T) {
+
+	manifest := reply.NewErrorItem("example-404-error").
+		Title("Resource Not Found").
+		Detail("The requested resource could not be found").
+		Status(http.StatusNotFound).
+		Code("E404").
+		About("https://example.com/errors/E404").
+		Translation("fr", reply.ErrorManifestItemTranslation{Title: "Ressource introuvable"}).
+		Build()
+
+	assert.Equal(t, reply.ErrorManifest{
+		"example-404-error": reply.ErrorManifestItem{
+			Title:        "Resource Not Found",
+			Detail:       "The requested resource could not be found",
+			StatusCode:   http.StatusNotFound,
+			Code:         "E404",
+			About:        "https://example.com/errors/E404",
+			Translations: map[string]reply.ErrorManifestItemTranslation{"fr": {Title: "Ressource introuvable"}},
+		},
+	}, manifest)
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{manifest})
+
+	assert.NoError(t, replier.NewHTTPErrorResponse(w, errors.New("example-404-error")))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestErrorItemBuilder_Validate(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		builder          *reply.ErrorItemBuilder
+		expectedProblems []string
+	}{
+		{
+			name:    "Success - Valid item",
+			builder: reply.NewErrorItem("example-404-error").Title("Resource Not Found").Status(http.StatusNotFound),
+		},
+		{
+			name:             "Failure - Missing title",
+			builder:          reply.NewErrorItem("example-404-error").Status(http.StatusNotFound),
+			expectedProblems: []string{"missing title"},
+		},
+		{
+			name:             "Failure - Missing key and invalid status code",
+			builder:          reply.NewErrorItem("").Title("Resource Not Found").Status(4040),
+			expectedProblems: []string{"missing key", "invalid status code 4040"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.builder.Validate()
+
+			if test.expectedProblems == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var invalidItemErr *reply.InvalidErrorItemError
+			assert.True(t, errors.As(err, &invalidItemErr))
+			assert.Equal(t, test.expectedProblems, invalidItemErr.Problems)
+		})
+	}
+}
+
+func TestNewErrorManifest(t *testing.T) {
+
+	t.Run("Success - Items keyed", func(t *testing.T) {
+		manifest, err := reply.NewErrorManifest(
+			reply.NewErrorItem("example-404-error").Title("Resource Not Found").Status(http.StatusNotFound),
+			reply.NewErrorItem("example-409-error").Title("Conflict").Status(http.StatusConflict),
+		)
+
+		assert.NoError(t, err)
+		assert.Len(t, manifest, 2)
+		assert.Equal(t, "Conflict", manifest["example-409-error"].Title)
+	})
+
+	t.Run("Failure - Repeated key", func(t *testing.T) {
+		_, err := reply.NewErrorManifest(
+			reply.NewErrorItem("example-404-error").Title("Resource Not Found"),
+			reply.NewErrorItem("example-404-error").Title("Not Found"),
+		)
+
+		assert.EqualError(t, err, `reply/manifest: invalid manifest item "example-404-error": repeated key`)
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// WithErrorMeta adds request specific meta (i.e. the offending value, a limit or
+// retry hints) to every error in the generated response, without modifying
+// the shared manifest item.
+//
+// NOTE - When both the manifest item's meta and the passed meta are maps, they
+// are deep merged with the passed meta taking precedence, otherwise the passed
+// meta replaces the manifest item's
+func WithErrorMeta(meta interface{}) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.ErrorMeta = meta
+	}
+}
+
+// applyErrorMeta returns the manifest item with the response's error meta
+// applied to its meta
+func applyErrorMeta(item ErrorManifestItem, response *NewResponseRequest) ErrorManifestItem {
+	if response == nil || response.ErrorMeta == nil {
+		return item
+	}
+
+	itemMeta, itemIsMap := item.Meta.(map[string]interface{})
+	errorMeta, errorIsMap := response.ErrorMeta.(map[string]interface{})
+	if itemIsMap && errorIsMap {
+		item.Meta = mergeMeta(itemMeta, errorMeta)
+		return item
+	}
+
+	item.Meta = response.ErrorMeta
+
+	return item
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithErrorMeta(t *testing.T) {
+
+	errLimitExceeded := errors.New("limit exceeded")
+	errInvalidName := errors.New("invalid name")
+
+	manifestMeta := map[string]interface{}{"docs": "https://example.com/limits"}
+
+	replier := reply.NewReplier([]reply.ErrorManifest{
+		{
+			errLimitExceeded.Error(): reply.ErrorManifestItem{Title: "Limit Exceeded", StatusCode: http.StatusTooManyRequests, Meta: manifestMeta},
+			errInvalidName.Error():   reply.ErrorManifestItem{Title: "Invalid Name", StatusCode: http.StatusBadRequest, Meta: "see docs"},
+		},
+	})
+
+	tests := []struct {
+		name         string
+		send         func(w http.ResponseWriter) error
+		expectedBody string
+	}{
+		{
+			name: "Success - Meta merged with manifest item meta",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errLimitExceeded, reply.WithErrorMeta(map[string]interface{}{"limit": 100, "retry_after": 30}))
+			},
+			expectedBody: `{"errors":[{"title":"Limit Exceeded","status":"429","meta":{"docs":"https://example.com/limits","limit":100,"retry_after":30}}]}`,
+		},
+		{
+			name: "Success - Meta replaces non map manifest item meta",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errInvalidName, reply.WithErrorMeta(map[string]interface{}{"value": "j0hn"}))
+			},
+			expectedBody: `{"errors":[{"title":"Invalid Name","status":"400","meta":{"value":"j0hn"}}]}`,
+		},
+		{
+			name: "Success - Meta added to every error",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPMultiErrorResponse(w, []error{errLimitExceeded, errInvalidName}, reply.WithErrorMeta("request 1"))
+			},
+			expectedBody: `{"errors":[{"title":"Limit Exceeded","status":"429","meta":"request 1"},{"title":"Invalid Name","status":"400","meta":"request 1"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			assert.NoError(t, test.send(w))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, map[string]interface{}{"docs": "https://example.com/limits"}, manifestMeta)
+		})
+	}
+}
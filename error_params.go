@@ -0,0 +1,84 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errorWithParams wraps an error with the parameters used to fill the
+// placeholders of its manifest item
+type errorWithParams struct {
+	err    error
+	params map[string]interface{}
+}
+
+// Error returns the wrapped error's value, so it can still be used to
+// look up its manifest item
+func (e *errorWithParams) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *errorWithParams) Unwrap() error {
+	return e.err
+}
+
+// ErrorWithParams wraps the passed error with parameters used to fill the
+// `{placeholder}` values in its manifest item's `Title` and `Detail`,
+// i.e. a detail of `{field} must be at least {min} characters`.
+//
+// NOTE - Parameters on the error take precedence over parameters passed
+// with the `WithErrorParams` response attribute
+func ErrorWithParams(err error, params map[string]interface{}) error {
+	return &errorWithParams{err: err, params: params}
+}
+
+// WithErrorParams adds parameters used to fill the `{placeholder}` values in
+// the `Title` and `Detail` of every error in the generated response
+func WithErrorParams(params map[string]interface{}) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.ErrorParams = params
+	}
+}
+
+// applyErrorParams returns the manifest item with the placeholders in its
+// title and detail filled using the response's error parameters and the
+// parameters the error was wrapped with
+func applyErrorParams(item ErrorManifestItem, err error, response *NewResponseRequest) ErrorManifestItem {
+
+	params := map[string]interface{}{}
+
+	if response != nil {
+		for key, value := range response.ErrorParams {
+			params[key] = value
+		}
+	}
+
+	var withParams *errorWithParams
+	if errors.As(err, &withParams) {
+		for key, value := range withParams.params {
+			params[key] = value
+		}
+	}
+
+	if len(params) == 0 {
+		return item
+	}
+
+	replacements := make([]string, 0, len(params)*2)
+	for key, value := range params {
+		replacements = append(replacements, "{"+key+"}", fmt.Sprint(value))
+	}
+
+	replacer := strings.NewReplacer(replacements...)
+
+	item.Title = replacer.Replace(item.Title)
+	item.Detail = replacer.Replace(item.Detail)
+
+	return item
+}
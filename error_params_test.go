@@ -0,0 +1,68 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ErrorParams(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-length-validation-error": reply.ErrorManifestItem{Title: "Invalid {field}", Detail: "{field} must be at least {min} characters", StatusCode: http.StatusBadRequest}},
+	}
+
+	tests := []struct {
+		name               string
+		errs               []error
+		responseAttributes []reply.ResponseAttributes
+		expectedBody       string
+	}{
+		{
+			name: "Success - Placeholders filled from response attribute",
+			errs: []error{errors.New("example-length-validation-error")},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithErrorParams(map[string]interface{}{"field": "name", "min": 3}),
+			},
+			expectedBody: `{"errors":[{"title":"Invalid name","detail":"name must be at least 3 characters","status":"400"}]}`,
+		},
+		{
+			name: "Success - Placeholders filled per error, taking precedence over attribute",
+			errs: []error{
+				reply.ErrorWithParams(errors.New("example-length-validation-error"), map[string]interface{}{"field": "name"}),
+				reply.ErrorWithParams(errors.New("example-length-validation-error"), map[string]interface{}{"field": "surname", "min": 2}),
+			},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithErrorParams(map[string]interface{}{"min": 3}),
+			},
+			expectedBody: `{"errors":[{"title":"Invalid name","detail":"name must be at least 3 characters","status":"400"},{"title":"Invalid surname","detail":"surname must be at least 2 characters","status":"400"}]}`,
+		},
+		{
+			name:         "Success - Placeholders left untouched without params",
+			errs:         []error{errors.New("example-length-validation-error")},
+			expectedBody: `{"errors":[{"title":"Invalid {field}","detail":"{field} must be at least {min} characters","status":"400"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests)
+
+			err := replier.NewHTTPMultiErrorResponse(w, test.errs, test.responseAttributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoWriter is returned, wrapped, when a response is requested without
+	// a writer
+	ErrNoWriter = errors.New("no writer provided")
+
+	// ErrNoTokens is returned, wrapped, when a token response is requested
+	// without any tokens
+	ErrNoTokens = errors.New("at least one token must be returned")
+)
+
+// EncodeError is returned when the response body could not be encoded. A
+// fallback `500 - Internal Server Error` response will have been sent in
+// its place.
+type EncodeError struct {
+	Cause error
+}
+
+// Error returns the encoding failure
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("reply/http-response: failed to encode transfer object with %v", e.Cause)
+}
+
+// Unwrap returns the cause of the encoding failure
+func (e *EncodeError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_TypedErrors(t *testing.T) {
+
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	tests := []struct {
+		name        string
+		send        func() error
+		expectedErr error
+		expectedMsg string
+	}{
+		{
+			name: "Failure - No writer",
+			send: func() error {
+				return replier.NewHTTPResponse(&reply.NewResponseRequest{})
+			},
+			expectedErr: reply.ErrNoWriter,
+			expectedMsg: "reply/http-response: failed to send response, no writer provided",
+		},
+		{
+			name: "Failure - No writer on aide",
+			send: func() error {
+				return replier.NewHTTPCSVResponse(nil, 200, [][]string{})
+			},
+			expectedErr: reply.ErrNoWriter,
+			expectedMsg: "reply/http-csv-aide: failed to send response, no writer provided",
+		},
+		{
+			name: "Failure - No tokens",
+			send: func() error {
+				return replier.NewHTTPTokenResponse(httptest.NewRecorder(), 200, "", "")
+			},
+			expectedErr: reply.ErrNoTokens,
+			expectedMsg: "reply/http-token-aide: failed at least one token must be returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.send()
+
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedMsg, err.Error())
+		})
+	}
+}
+
+func TestReplier_EncodeError(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	err := replier.NewHTTPDataResponse(w, 200, make(chan int))
+
+	var encodeErr *reply.EncodeError
+	assert.True(t, errors.As(err, &encodeErr))
+	assert.Error(t, encodeErr.Cause)
+	assert.Contains(t, err.Error(), "reply/http-response: failed to encode transfer object with json: unsupported type: chan int")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestReplier_EncodeFailureFallbackResponse(t *testing.T) {
+
+	tests := []struct {
+		name                string
+		options             []reply.Option
+		expectedStatusCode  int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "Success - Fallback error envelope",
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedContentType: "application/json",
+			expectedBody:        `{"errors":[{"title":"Internal Server Error","status":"500"}]}` + "\n",
+		},
+		{
+			name: "Success - Plain-text fallback when envelope fails to encode",
+			options: []reply.Option{reply.WithEncoder(reply.EncoderFunc(func(w io.Writer, v interface{}) error {
+				return errors.New("encoder unavailable")
+			}))},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "Internal Server Error\n",
+		},
+		{
+			name:                "Success - Custom fallback error manifest item",
+			options:             []reply.Option{reply.WithFallbackErrorManifestItem(reply.ErrorManifestItem{Title: "Service Unavailable", Code: "SVC-503", StatusCode: http.StatusServiceUnavailable})},
+			expectedStatusCode:  http.StatusServiceUnavailable,
+			expectedContentType: "application/json",
+			expectedBody:        `{"errors":[{"title":"Service Unavailable","status":"503","code":"SVC-503"}]}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{}, test.options...)
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, make(chan int))
+
+			var encodeErr *reply.EncodeError
+			assert.ErrorAs(t, err, &encodeErr)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, test.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// WithAutoFlush flushes every response to the client once it has been written,
+// so proxies and browsers receive it before the handler returns
+//
+// NOTE - Writers that do not implement `http.Flusher` are not flushed
+func WithAutoFlush() Option {
+	return func(r *Replier) {
+		r.autoFlush = true
+	}
+}
+
+// WithFlush flushes the response to the client once it has been written,
+// regardless of the replier's configuration
+func WithFlush() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Flush = true
+	}
+}
+
+// flushResponse flushes the response's writer if flushing is enabled for
+// the response
+func (r *Replier) flushResponse(response *NewResponseRequest) {
+	if !r.autoFlush && !response.Flush {
+		return
+	}
+
+	flushWriter(response.Writer)
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_Flush(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		options         []reply.Option
+		attributes      []reply.ResponseAttributes
+		expectedFlushed bool
+	}{
+		{
+			name: "Success - Not flushed by default",
+		},
+		{
+			name:            "Success - Flushed by response attribute",
+			attributes:      []reply.ResponseAttributes{reply.WithFlush()},
+			expectedFlushed: true,
+		},
+		{
+			name:            "Success - Flushed by replier option",
+			options:         []reply.Option{reply.WithAutoFlush()},
+			expectedFlushed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedFlushed, w.Flushed)
+			assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+		})
+	}
+}
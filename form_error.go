@@ -0,0 +1,130 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+)
+
+const (
+	// formPartMetaKey is the error meta key holding the name of the form part
+	// that caused the error
+	formPartMetaKey = "part"
+)
+
+var (
+	// ErrFormNotMultipart is used by NewHTTPFormErrorResponse for requests whose
+	// body is not `multipart/form-data`, its manifest item can be replaced using
+	// the `form-not-multipart` manifest key
+	ErrFormNotMultipart error = &defaultItemError{
+		key:  "form-not-multipart",
+		item: ErrorManifestItem{Title: "Unsupported Media Type", Detail: "The request body must be multipart/form-data", StatusCode: http.StatusUnsupportedMediaType},
+	}
+
+	// ErrFormMalformed is used by NewHTTPFormErrorResponse for forms that could
+	// not be parsed, its manifest item can be replaced using the `form-malformed`
+	// manifest key
+	ErrFormMalformed error = &defaultItemError{
+		key:  "form-malformed",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request form could not be parsed", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrFormTooLarge is used by NewHTTPFormErrorResponse for forms exceeding the
+	// limit of an `http.MaxBytesReader` or the memory passed to `ParseMultipartForm`,
+	// its manifest item can be replaced using the `form-too-large` manifest key
+	ErrFormTooLarge error = &defaultItemError{
+		key:  "form-too-large",
+		item: ErrorManifestItem{Title: "Request Entity Too Large", Detail: "The request form is too large", StatusCode: http.StatusRequestEntityTooLarge},
+	}
+
+	// ErrFormPartMissing is used by NewHTTPFormErrorResponse for form parts that
+	// are not in the request, its manifest item can be replaced using the
+	// `form-part-missing` manifest key. The `{part}` placeholder holds the part's name.
+	ErrFormPartMissing error = &defaultItemError{
+		key:  "form-part-missing",
+		item: ErrorManifestItem{Title: "Bad Request", Detail: "The request form part {part} is missing", StatusCode: http.StatusBadRequest},
+	}
+
+	// ErrFormPartUnsupportedType can be passed to NewHTTPFormErrorResponse for
+	// uploaded files whose content type is not accepted, its manifest item can be
+	// replaced using the `form-part-unsupported-type` manifest key. The `{part}`
+	// placeholder holds the part's name.
+	ErrFormPartUnsupportedType error = &defaultItemError{
+		key:  "form-part-unsupported-type",
+		item: ErrorManifestItem{Title: "Unsupported Media Type", Detail: "The request form part {part} has an unsupported content type", StatusCode: http.StatusUnsupportedMediaType},
+	}
+)
+
+// NewHTTPFormErrorResponse this response aide is used to create an error response
+// for an error returned while parsing a form or reading an uploaded file, i.e. by
+// `r.ParseMultipartForm` or `r.FormFile(part)`, naming the offending part (if
+// known) under the `part` key of the error's meta:
+//
+// - `http.ErrNotMultipart` uses ErrFormNotMultipart (415)
+//
+// - `*http.MaxBytesError` and `multipart.ErrMessageTooLarge` use ErrFormTooLarge (413)
+//
+// - `http.ErrMissingFile` uses ErrFormPartMissing (400)
+//
+// - any other error, i.e. `http.ErrMissingBoundary`, uses ErrFormMalformed (400)
+//
+// The Err* form errors, i.e. ErrFormPartUnsupportedType, can be passed as they are.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+func (r *Replier) NewHTTPFormErrorResponse(w http.ResponseWriter, err error, part string, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  convertFormError(err),
+	}
+
+	if !isEmpty(part) {
+		params := map[string]interface{}{formPartMetaKey: part}
+
+		request.Error = ErrorWithParams(request.Error, params)
+		request.ErrorMeta = params
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// convertFormError returns the form error describing the parsing error
+func convertFormError(err error) error {
+
+	var maxBytesErr *http.MaxBytesError
+	var itemErr *defaultItemError
+
+	switch {
+	case errors.As(err, &itemErr) && isFormError(itemErr):
+		return itemErr
+	case errors.Is(err, http.ErrNotMultipart):
+		return ErrFormNotMultipart
+	case errors.As(err, &maxBytesErr), errors.Is(err, multipart.ErrMessageTooLarge):
+		return ErrFormTooLarge
+	case errors.Is(err, http.ErrMissingFile):
+		return ErrFormPartMissing
+	}
+
+	return ErrFormMalformed
+}
+
+// isFormError returns whether the error is one of the form errors
+func isFormError(err error) bool {
+	for _, formErr := range []error{ErrFormNotMultipart, ErrFormMalformed, ErrFormTooLarge, ErrFormPartMissing, ErrFormPartUnsupportedType} {
+		if err == formErr {
+			return true
+		}
+	}
+
+	return false
+}
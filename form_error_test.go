@@ -0,0 +1,123 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestMultipartRequest returns a request holding a multipart form with the
+// passed file part
+func getTestMultipartRequest(t *testing.T, part, content string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	file, err := writer.CreateFormFile(part, "upload.txt")
+	assert.NoError(t, err)
+	_, err = file.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return req
+}
+
+func TestReplier_NewHTTPFormErrorResponse(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		err                func(t *testing.T) error
+		part               string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Success - Body not multipart returns unsupported media type",
+			err: func(t *testing.T) error {
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+				req.Header.Set("Content-Type", "application/json")
+				return req.ParseMultipartForm(1024)
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedBody:       `{"errors":[{"title":"Unsupported Media Type","detail":"The request body must be multipart/form-data","status":"415"}]}`,
+		},
+		{
+			name: "Success - Form exceeding limit returns request entity too large",
+			err: func(t *testing.T) error {
+				req := getTestMultipartRequest(t, "avatar", strings.Repeat("a", 1024))
+				req.Body = http.MaxBytesReader(httptest.NewRecorder(), req.Body, 64)
+				return req.ParseMultipartForm(1024)
+			},
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedBody:       `{"errors":[{"title":"Request Entity Too Large","detail":"The request form is too large","status":"413"}]}`,
+		},
+		{
+			name: "Success - Missing file names part",
+			err: func(t *testing.T) error {
+				_, _, err := getTestMultipartRequest(t, "avatar", "content").FormFile("document")
+				return err
+			},
+			part:               "document",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request form part document is missing","status":"400","meta":{"part":"document"}}]}`,
+		},
+		{
+			name: "Success - Missing boundary returns malformed form",
+			err: func(t *testing.T) error {
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`content`))
+				req.Header.Set("Content-Type", "multipart/form-data")
+				return req.ParseMultipartForm(1024)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Bad Request","detail":"The request form could not be parsed","status":"400"}]}`,
+		},
+		{
+			name: "Success - Form error passed as is",
+			err: func(t *testing.T) error {
+				return reply.ErrFormPartUnsupportedType
+			},
+			part:               "avatar",
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedBody:       `{"errors":[{"title":"Unsupported Media Type","detail":"The request form part avatar has an unsupported content type","status":"415","meta":{"part":"avatar"}}]}`,
+		},
+		{
+			name: "Success - Manifest entry replaces default item",
+			manifests: []reply.ErrorManifest{
+				{"form-part-missing": reply.ErrorManifestItem{Title: "Upload Missing", Detail: "Attach a {part}", Code: "F400", StatusCode: http.StatusBadRequest, Meta: map[string]interface{}{"docs": "www.example.com/uploads"}}},
+			},
+			err: func(t *testing.T) error {
+				return fmt.Errorf("failed to read upload: %w", http.ErrMissingFile)
+			},
+			part:               "avatar",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Upload Missing","detail":"Attach a avatar","status":"400","code":"F400","meta":{"docs":"www.example.com/uploads","part":"avatar"}}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPFormErrorResponse(w, test.err(t), test.part)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
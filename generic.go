@@ -0,0 +1,44 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// Data this response aide works like NewHTTPDataResponse, with the data's type
+// checked at compile time
+func Data[T any](replier *Replier, w http.ResponseWriter, statusCode int, data T, attributes ...ResponseAttributes) error {
+	return replier.NewHTTPDataResponse(w, statusCode, data, attributes...)
+}
+
+// DataWithResult this response aide works like NewHTTPDataResponseWithResult,
+// with the data's type checked at compile time
+func DataWithResult[T any](replier *Replier, w http.ResponseWriter, statusCode int, data T, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	return replier.NewHTTPDataResponseWithResult(w, statusCode, data, attributes...)
+}
+
+// DataStream this response aide works like NewHTTPDataStreamResponse, streaming
+// the items received from a typed channel
+func DataStream[T any](replier *Replier, w http.ResponseWriter, statusCode int, rows <-chan T, attributes ...ResponseAttributes) error {
+
+	items := make(chan interface{})
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(items)
+
+		for row := range rows {
+			select {
+			case items <- row:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return replier.NewHTTPDataStreamResponse(w, statusCode, items, attributes...)
+}
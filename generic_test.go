@@ -0,0 +1,54 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestData(t *testing.T) {
+
+	t.Run("Success - Typed data", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getEmptyErrorManifest())
+
+		err := reply.Data(replier, w, http.StatusCreated, getTestUser())
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+	})
+
+	t.Run("Success - Typed data with result", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getEmptyErrorManifest())
+
+		result, err := reply.DataWithResult(replier, w, http.StatusOK, []string{"a", "b"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, stringWithNewLine(`{"data":["a","b"]}`), w.Body.String())
+	})
+
+	t.Run("Success - Typed data stream", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getEmptyErrorManifest())
+
+		rows := make(chan user, 2)
+		rows <- getTestUser()
+		rows <- getTestUser()
+		close(rows)
+
+		err := reply.DataStream(replier, w, http.StatusOK, rows)
+
+		assert.NoError(t, err)
+		assert.Equal(t, stringWithNewLine(`{"data":[{"id":"some-id","name":"john doe"},{"id":"some-id","name":"john doe"}]}`), w.Body.String())
+	})
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// WithGraphQLFormat sets the transfer object and transfer object error used by the
+// replier to render responses in the format outlined by the GraphQL specification,
+// so GraphQL gateways can pass them through unchanged
+func WithGraphQLFormat() Option {
+	return func(r *Replier) {
+		r.transferObject = &GraphQLTransferObject{}
+		r.transferObjectError = &GraphQLTransferObjectError{}
+	}
+}
+
+// GraphQLTransferObjectErrorExtensions holds the additional attributes of a
+// GraphQL error
+type GraphQLTransferObjectErrorExtensions struct {
+
+	// Code internal error code used to reference error
+	Code string `json:"code,omitempty"`
+
+	// Status the HTTP status associated with error
+	Status int `json:"status,omitempty"`
+
+	// Title a short summary of the problem, set when the message holds
+	// the error's detail
+	Title string `json:"title,omitempty"`
+
+	// About holds the link that gives further insight into the error
+	About string `json:"about,omitempty"`
+
+	// Meta contains additional meta-information about the error
+	Meta interface{} `json:"meta,omitempty"`
+}
+
+// GraphQLTransferObjectError represents errors as outlined by the GraphQL
+// specification, with `message`, `path` and `extensions`
+type GraphQLTransferObjectError struct {
+
+	// Message a description of the error, the error's detail if set
+	// otherwise its title
+	Message string `json:"message"`
+
+	// Path the path to the field that caused the error
+	Path []interface{} `json:"path,omitempty"`
+
+	// Extensions holds the additional attributes of the error
+	Extensions GraphQLTransferObjectErrorExtensions `json:"extensions"`
+
+	title  string
+	detail string
+}
+
+// SetTitle adds title to error
+func (e *GraphQLTransferObjectError) SetTitle(title string) {
+	e.title = title
+	e.setMessage()
+}
+
+// GetTitle returns error's title
+func (e *GraphQLTransferObjectError) GetTitle() string {
+	return e.title
+}
+
+// SetDetail adds detail to error
+func (e *GraphQLTransferObjectError) SetDetail(detail string) {
+	e.detail = detail
+	e.setMessage()
+}
+
+// GetDetail return error's detail
+func (e *GraphQLTransferObjectError) GetDetail() string {
+	return e.detail
+}
+
+// SetAbout adds about to error
+func (e *GraphQLTransferObjectError) SetAbout(about string) {
+	e.Extensions.About = about
+}
+
+// GetAbout return error's about
+func (e *GraphQLTransferObjectError) GetAbout() string {
+	return e.Extensions.About
+}
+
+// SetStatusCode adds http status code to error
+func (e *GraphQLTransferObjectError) SetStatusCode(status int) {
+	e.Extensions.Status = status
+}
+
+// GetStatusCode returns error's HTTP status code
+func (e *GraphQLTransferObjectError) GetStatusCode() string {
+	if e.Extensions.Status == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(e.Extensions.Status)
+}
+
+// SetCode adds internal code to error
+func (e *GraphQLTransferObjectError) SetCode(code string) {
+	e.Extensions.Code = code
+}
+
+// GetCode returns error's internal code
+func (e *GraphQLTransferObjectError) GetCode() string {
+	return e.Extensions.Code
+}
+
+// SetMeta adds meta property to error
+func (e *GraphQLTransferObjectError) SetMeta(meta interface{}) {
+	e.Extensions.Meta = meta
+}
+
+// GetMeta returns error's meta property
+func (e *GraphQLTransferObjectError) GetMeta() interface{} {
+	return e.Extensions.Meta
+}
+
+// SetSource converts the pointer (i.e. `/user/friends/0`) into the error's
+// path. If no pointer is passed, the parameter is used as the path.
+func (e *GraphQLTransferObjectError) SetSource(pointer, parameter string) {
+	e.Path = nil
+
+	if isEmpty(pointer) {
+		if !isEmpty(parameter) {
+			e.Path = []interface{}{parameter}
+		}
+		return
+	}
+
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if index, err := strconv.Atoi(segment); err == nil {
+			e.Path = append(e.Path, index)
+			continue
+		}
+		e.Path = append(e.Path, segment)
+	}
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+// error
+func (e *GraphQLTransferObjectError) RefreshTransferObject() TransferObjectError {
+	return &GraphQLTransferObjectError{}
+}
+
+// setMessage sets the message to the detail if set, otherwise the title. The
+// title is added to the extensions when the detail is used.
+func (e *GraphQLTransferObjectError) setMessage() {
+	if isEmpty(e.detail) {
+		e.Message = e.title
+		e.Extensions.Title = ""
+		return
+	}
+
+	e.Message = e.detail
+	e.Extensions.Title = e.title
+}
+
+// GraphQLTransferObject represents responses as outlined by the GraphQL
+// specification, with `data`, `errors` and `extensions` (meta)
+type GraphQLTransferObject struct {
+	HTTPWriter http.ResponseWriter    `json:"-"`
+	Headers    map[string]string      `json:"-"`
+	StatusCode int                    `json:"-"`
+	Errors     []TransferObjectError  `json:"errors,omitempty"`
+	Data       interface{}            `json:"data,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// SetHeaders adds headers to transfer object
+func (t *GraphQLTransferObject) SetHeaders(headers map[string]string) {
+	t.Headers = headers
+}
+
+// SetStatusCode adds status code to transfer object
+func (t *GraphQLTransferObject) SetStatusCode(code int) {
+	t.StatusCode = code
+}
+
+// SetMeta adds meta property to transfer object's extensions
+func (t *GraphQLTransferObject) SetMeta(meta map[string]interface{}) {
+	t.Extensions = meta
+}
+
+// SetWriter adds writer to transfer object
+func (t *GraphQLTransferObject) SetWriter(writer http.ResponseWriter) {
+	t.HTTPWriter = writer
+}
+
+// SetTokenOne adds token one to transfer object's data as `access_token`
+func (t *GraphQLTransferObject) SetTokenOne(token string) {
+	t.setToken("access_token", token)
+}
+
+// SetTokenTwo adds token two to transfer object's data as `refresh_token`
+func (t *GraphQLTransferObject) SetTokenTwo(token string) {
+	t.setToken("refresh_token", token)
+}
+
+// GetWriter returns the writer assigned with the transfer object
+func (t *GraphQLTransferObject) GetWriter() http.ResponseWriter {
+	return t.HTTPWriter
+}
+
+// GetStatusCode returns the status code assigned to the transfer object
+func (t *GraphQLTransferObject) GetStatusCode() int {
+	return t.StatusCode
+}
+
+// SetData adds passed data to the transfer object
+func (t *GraphQLTransferObject) SetData(data interface{}) {
+	t.Data = data
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+func (t *GraphQLTransferObject) RefreshTransferObject() TransferObject {
+	return &GraphQLTransferObject{}
+}
+
+// Reset clears the transfer object so it can be reused for the next response
+func (t *GraphQLTransferObject) Reset() {
+	*t = GraphQLTransferObject{}
+}
+
+// SetErrors assigns the passed transfer object errors to the transfer object
+func (t *GraphQLTransferObject) SetErrors(transferObjectErrors []TransferObjectError) {
+	t.Errors = transferObjectErrors
+}
+
+// setToken adds the token to the transfer object's data under the passed key
+func (t *GraphQLTransferObject) setToken(key, token string) {
+	if isEmpty(token) {
+		return
+	}
+
+	tokens, ok := t.Data.(map[string]string)
+	if !ok {
+		tokens = map[string]string{}
+		t.Data = tokens
+	}
+
+	tokens[key] = token
+}
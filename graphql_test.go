@@ -0,0 +1,70 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithGraphQLFormat(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		request            reply.NewResponseRequest
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success - Data with extensions",
+			request: reply.NewResponseRequest{
+				Data: getTestUser(),
+				Meta: getReplyFormattedMeta(),
+			},
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"extensions":{"example":"meta in response"}}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Errors rendered with message, path and extensions",
+			request: reply.NewResponseRequest{
+				Errors: []error{
+					getExampleErrorOne(),
+					reply.ErrorWithSource(errors.New("example-name-validation-error"), "/user/friends/0/name", ""),
+				},
+			},
+			expectedBody:       `{"errors":[{"message":"Resource Not Found","extensions":{"status":404}},{"message":"The name provided does not meet validation requirements","path":["user","friends",0,"name"],"extensions":{"code":"1011","status":400,"title":"Validation Error","about":"www.example.com/reply/validation/1011"}}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Success - Tokens rendered in data",
+			request: reply.NewResponseRequest{
+				TokenOne: "test-token-1",
+				TokenTwo: "test-token-2",
+			},
+			expectedBody:       `{"data":{"access_token":"test-token-1","refresh_token":"test-token-2"}}`,
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithGraphQLFormat())
+
+			test.request.Writer = w
+			err := replier.NewHTTPResponse(&test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// halContentType is the content type of HAL responses
+	halContentType = "application/hal+json"
+)
+
+// HALLink represents a HAL link object
+type HALLink struct {
+	Href      string `json:"href"`
+	Templated bool   `json:"templated,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+// TransferObjectLinks outlines the optional methods a transfer object can implement
+// to receive the link relations and embedded resources of the response
+type TransferObjectLinks interface {
+	SetLinks(links map[string][]HALLink)
+	SetEmbedded(embedded map[string]interface{})
+}
+
+// WithHAL sets the transfer object used by the replier to render responses as
+// HAL (`application/hal+json`), with `_links` and `_embedded` sections
+func WithHAL() Option {
+	return func(r *Replier) {
+		r.transferObject = &HALTransferObject{}
+		r.contentType = halContentType
+	}
+}
+
+// WithLink adds a link relation to the generated response. Adding multiple links
+// with the same relation renders them as an array.
+func WithLink(rel string, link HALLink) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		if r.Links == nil {
+			r.Links = map[string][]HALLink{}
+		}
+		r.Links[rel] = append(r.Links[rel], link)
+	}
+}
+
+// WithEmbedded adds an embedded resource (or collection of resources) to the
+// generated response under the passed relation
+func WithEmbedded(rel string, resource interface{}) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		if r.Embedded == nil {
+			r.Embedded = map[string]interface{}{}
+		}
+		r.Embedded[rel] = resource
+	}
+}
+
+// setLinks passes the response's link relations and embedded resources to the
+// transfer object, if it implements TransferObjectLinks
+func (r *Replier) setLinks(response *NewResponseRequest) {
+	linksTransferObject, ok := response.transferObject.(TransferObjectLinks)
+	if !ok {
+		return
+	}
+
+	linksTransferObject.SetLinks(response.Links)
+	linksTransferObject.SetEmbedded(response.Embedded)
+}
+
+// HALTransferObject represents responses as HAL resources. Object data is
+// rendered at the top level alongside `_links` and `_embedded`, other data
+// is rendered under `data`.
+type HALTransferObject struct {
+	HTTPWriter http.ResponseWriter
+	Headers    map[string]string
+	StatusCode int
+	Errors     []TransferObjectError
+	Data       interface{}
+	TokenOne   string
+	TokenTwo   string
+	Meta       map[string]interface{}
+	Links      map[string][]HALLink
+	Embedded   map[string]interface{}
+}
+
+// SetHeaders adds headers to transfer object
+func (t *HALTransferObject) SetHeaders(headers map[string]string) {
+	t.Headers = headers
+}
+
+// SetStatusCode adds status code to transfer object
+func (t *HALTransferObject) SetStatusCode(code int) {
+	t.StatusCode = code
+}
+
+// SetMeta adds meta property to transfer object
+func (t *HALTransferObject) SetMeta(meta map[string]interface{}) {
+	t.Meta = meta
+}
+
+// SetWriter adds writer to transfer object
+func (t *HALTransferObject) SetWriter(writer http.ResponseWriter) {
+	t.HTTPWriter = writer
+}
+
+// SetTokenOne sets token value to token one on transfer object
+func (t *HALTransferObject) SetTokenOne(token string) {
+	t.TokenOne = token
+}
+
+// SetTokenTwo sets token value to token two on transfer object
+func (t *HALTransferObject) SetTokenTwo(token string) {
+	t.TokenTwo = token
+}
+
+// GetWriter returns the writer assigned with the transfer object
+func (t *HALTransferObject) GetWriter() http.ResponseWriter {
+	return t.HTTPWriter
+}
+
+// GetStatusCode returns the status code assigned to the transfer object
+func (t *HALTransferObject) GetStatusCode() int {
+	return t.StatusCode
+}
+
+// SetData adds passed data to the transfer object
+func (t *HALTransferObject) SetData(data interface{}) {
+	t.Data = data
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+func (t *HALTransferObject) RefreshTransferObject() TransferObject {
+	return &HALTransferObject{}
+}
+
+// Reset clears the transfer object so it can be reused for the next response
+func (t *HALTransferObject) Reset() {
+	*t = HALTransferObject{}
+}
+
+// SetErrors assigns the passed transfer object errors to the transfer object
+func (t *HALTransferObject) SetErrors(transferObjectErrors []TransferObjectError) {
+	t.Errors = transferObjectErrors
+}
+
+// SetLinks adds the link relations to the transfer object
+func (t *HALTransferObject) SetLinks(links map[string][]HALLink) {
+	t.Links = links
+}
+
+// SetEmbedded adds the embedded resources to the transfer object
+func (t *HALTransferObject) SetEmbedded(embedded map[string]interface{}) {
+	t.Embedded = embedded
+}
+
+// MarshalJSON renders the transfer object as a HAL resource
+func (t *HALTransferObject) MarshalJSON() ([]byte, error) {
+
+	resource := map[string]interface{}{}
+
+	if err := t.addData(resource); err != nil {
+		return nil, err
+	}
+
+	if len(t.Links) > 0 {
+		links := make(map[string]interface{}, len(t.Links))
+		for rel, relLinks := range t.Links {
+			if len(relLinks) == 1 {
+				links[rel] = relLinks[0]
+				continue
+			}
+			links[rel] = relLinks
+		}
+		resource["_links"] = links
+	}
+
+	if len(t.Embedded) > 0 {
+		resource["_embedded"] = t.Embedded
+	}
+
+	if len(t.Errors) > 0 {
+		resource["errors"] = t.Errors
+	}
+
+	if len(t.Meta) > 0 {
+		resource["meta"] = t.Meta
+	}
+
+	if !isEmpty(t.TokenOne) {
+		resource["access_token"] = t.TokenOne
+	}
+
+	if !isEmpty(t.TokenTwo) {
+		resource["refresh_token"] = t.TokenTwo
+	}
+
+	return json.Marshal(resource)
+}
+
+// addData adds the transfer object's data to the resource. JSON objects are
+// added at the top level, other values are added under `data`.
+func (t *HALTransferObject) addData(resource map[string]interface{}) error {
+
+	if t.Data == nil || t.Data == defaultResponseBody {
+		return nil
+	}
+
+	encodedData, err := json.Marshal(t.Data)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(encodedData, &fields); err != nil {
+		resource["data"] = json.RawMessage(encodedData)
+		return nil
+	}
+
+	for key, value := range fields {
+		resource[key] = value
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithHAL(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		statusCode         int
+		data               interface{}
+		responseAttributes []reply.ResponseAttributes
+		expectedBody       string
+	}{
+		{
+			name:       "Success - Object data rendered with links",
+			statusCode: http.StatusOK,
+			data:       getTestUser(),
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithLink("self", reply.HALLink{Href: "/users/some-id"}),
+				reply.WithLink("friends", reply.HALLink{Href: "/users/1"}),
+				reply.WithLink("friends", reply.HALLink{Href: "/users/2"}),
+			},
+			expectedBody: `{"_links":{"friends":[{"href":"/users/1"},{"href":"/users/2"}],"self":{"href":"/users/some-id"}},"id":"some-id","name":"john doe"}`,
+		},
+		{
+			name:       "Success - Collection data rendered with embedded resources and meta",
+			statusCode: http.StatusOK,
+			data:       []user{getTestUser()},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithEmbedded("users", []user{getTestUser()}),
+				reply.WithMeta(getReplyFormattedMeta()),
+			},
+			expectedBody: `{"_embedded":{"users":[{"id":"some-id","name":"john doe"}]},"data":[{"id":"some-id","name":"john doe"}],"meta":{"example":"meta in response"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithHAL())
+
+			err := replier.NewHTTPDataResponse(w, test.statusCode, test.data, test.responseAttributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.statusCode, w.Code)
+			assert.Equal(t, "application/hal+json", w.Header().Get("Content-Type"))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_WithHALBlankAndErrorResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithHAL())
+
+	err := replier.NewHTTPBlankResponse(w, http.StatusOK, reply.WithLink("self", reply.HALLink{Href: "/health"}))
+	assert.NoError(t, err)
+	assert.Equal(t, stringWithNewLine(`{"_links":{"self":{"href":"/health"}}}`), w.Body.String())
+
+	w = httptest.NewRecorder()
+	err = replier.NewHTTPErrorResponse(w, getExampleErrorOne())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), w.Body.String())
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// WithMethod sets the method of the request being responded to. Responses to
+// `HEAD` requests are fully encoded, so headers such as `Content-Length` match
+// those of the equivalent `GET` response, but no body is written.
+func WithMethod(method string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Method = method
+	}
+}
+
+// isHeadRequest returns whether the response is for a `HEAD` request
+func isHeadRequest(response *NewResponseRequest) bool {
+	return response.Method == http.MethodHead
+}
+
+// suppressResponseBody wraps the response's writer so the body is discarded,
+// returning a function that writes the status code if no body was written,
+// unless generating the response failed before a status code was set
+func suppressResponseBody(response *NewResponseRequest) func(err error) {
+	writer := &headResponseWriter{ResponseWriter: response.Writer}
+	response.Writer = writer
+
+	return func(err error) {
+		response.Writer = writer.ResponseWriter
+		if err != nil && writer.statusCode == 0 {
+			return
+		}
+
+		if writer.writes == 1 && !writer.headerWritten && writer.Header().Get("Content-Length") == "" {
+			writer.Header().Set("Content-Length", strconv.Itoa(writer.bytesWritten))
+		}
+
+		writer.writeHeader()
+	}
+}
+
+// headResponseWriter wraps a http.ResponseWriter, delaying the status code until
+// the body is complete so its `Content-Length` can be set, discarding the body
+type headResponseWriter struct {
+	http.ResponseWriter
+	statusCode    int
+	headerWritten bool
+	writes        int
+	bytesWritten  int
+}
+
+// WriteHeader records the status code to be written once the body is complete
+func (w *headResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+// Write records the body's length without writing the body.
+//
+// NOTE - `Content-Length` is only set for bodies written in a single write,
+// the length of streamed or chunked bodies is left unset
+func (w *headResponseWriter) Write(b []byte) (int, error) {
+	w.writes++
+	w.bytesWritten += len(b)
+
+	return len(b), nil
+}
+
+// writeHeader writes the recorded status code once
+func (w *headResponseWriter) writeHeader() {
+	if w.headerWritten {
+		return
+	}
+	w.headerWritten = true
+
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	w.ResponseWriter.WriteHeader(w.statusCode)
+}
+
+// Flush writes the status code before flushing the wrapped writer, if it
+// implements `http.Flusher`
+func (w *headResponseWriter) Flush() {
+	w.writeHeader()
+	flushWriter(w.ResponseWriter)
+}
+
+// Unwrap returns the wrapped writer, used by `http.ResponseController`
+func (w *headResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithMethod(t *testing.T) {
+
+	tests := []struct {
+		name                  string
+		method                string
+		options               []reply.Option
+		send                  func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error
+		expectedStatusCode    int
+		expectedBody          string
+		expectedContentLength string
+	}{
+		{
+			name:   "Success - GET writes body",
+			method: http.MethodGet,
+			send: func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), attributes...)
+			},
+			expectedStatusCode:    http.StatusOK,
+			expectedBody:          stringWithNewLine(getDataResponseBody()),
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getDataResponseBody()))),
+		},
+		{
+			name:   "Success - HEAD suppresses data body",
+			method: http.MethodHead,
+			send: func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), attributes...)
+			},
+			expectedStatusCode:    http.StatusOK,
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getDataResponseBody()))),
+		},
+		{
+			name:   "Success - HEAD suppresses error body",
+			method: http.MethodHead,
+			send: func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne(), attributes...)
+			},
+			expectedStatusCode:    http.StatusNotFound,
+			expectedContentLength: strconv.Itoa(len(stringWithNewLine(getErrorResponseForExampleErrorOne()))),
+		},
+		{
+			name:   "Success - HEAD streamed body not sized",
+			method: http.MethodHead,
+			send: func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error {
+				rows := make(chan interface{}, 2)
+				rows <- getTestUser()
+				rows <- getTestUser()
+				close(rows)
+				return replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows, attributes...)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "Success - HEAD chunked body not sized",
+			method:  http.MethodHead,
+			options: []reply.Option{reply.WithMaxBodySize(16, reply.BodySizeChunk)},
+			send: func(replier *reply.Replier, w http.ResponseWriter, attributes ...reply.ResponseAttributes) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), attributes...)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := test.send(replier, w, reply.WithMethod(test.method))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedBody, w.Body.String())
+			assert.Equal(t, test.expectedContentLength, w.Header().Get("Content-Length"))
+		})
+	}
+}
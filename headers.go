@@ -0,0 +1,78 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// WithHeaderValues adds passed multi-value headers on to the generated response,
+// replacing any values already set for each header, i.e. the default content
+// type or headers passed with WithHeaders.
+//
+// NOTE - Repeated calls are merged, with values from later calls replacing
+// those of earlier calls where headers are shared
+func WithHeaderValues(headers http.Header) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.HeaderValues = mergeHeaders(r.HeaderValues, headers, false)
+	}
+}
+
+// WithAddedHeaders adds passed multi-value headers on to the generated response,
+// appending to any values already set for each header, so multiple `Set-Cookie`,
+// `Link` or `Vary` values are not clobbered.
+//
+// NOTE - Repeated calls are merged, with values from later calls appended
+func WithAddedHeaders(headers http.Header) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.AddedHeaders = mergeHeaders(r.AddedHeaders, headers, true)
+	}
+}
+
+// writeResponseHeaders writes the response request's headers to the passed
+// header. Headers passed with WithHeaders and WithHeaderValues replace existing
+// values, then headers passed with WithAddedHeaders are appended
+func writeResponseHeaders(header http.Header, response *NewResponseRequest) {
+	for headerKey, headerValue := range response.Headers {
+		header.Set(headerKey, headerValue)
+	}
+
+	for headerKey, headerValues := range response.HeaderValues {
+		header.Del(headerKey)
+		for _, headerValue := range headerValues {
+			header.Add(headerKey, headerValue)
+		}
+	}
+
+	for headerKey, headerValues := range response.AddedHeaders {
+		for _, headerValue := range headerValues {
+			header.Add(headerKey, headerValue)
+		}
+	}
+}
+
+// mergeHeaders returns a new header holding the passed headers merged over the
+// base headers, with values appended where headers are shared if add is set,
+// otherwise replaced.
+//
+// NOTE - Neither the base nor the passed headers are modified
+func mergeHeaders(base, headers http.Header, add bool) http.Header {
+	mergedHeaders := make(http.Header, len(base)+len(headers))
+
+	for headerKey, headerValues := range base {
+		mergedHeaders[headerKey] = append([]string(nil), headerValues...)
+	}
+
+	for headerKey, headerValues := range headers {
+		headerKey = http.CanonicalHeaderKey(headerKey)
+		if !add {
+			mergedHeaders[headerKey] = nil
+		}
+
+		mergedHeaders[headerKey] = append(mergedHeaders[headerKey], headerValues...)
+	}
+
+	return mergedHeaders
+}
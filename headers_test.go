@@ -0,0 +1,91 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithHeaderValues(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		options         []reply.Option
+		origin          string
+		attributes      []reply.ResponseAttributes
+		expectedHeaders http.Header
+	}{
+		{
+			name: "Success - Multiple values set",
+			attributes: []reply.ResponseAttributes{
+				reply.WithHeaderValues(map[string][]string{"set-cookie": {"a=1", "b=2"}}),
+			},
+			expectedHeaders: http.Header{
+				"Content-Length": {"14"},
+				"Content-Type":   {"application/json"},
+				"Set-Cookie":     {"a=1", "b=2"},
+			},
+		},
+		{
+			name: "Success - Values replace headers already set",
+			attributes: []reply.ResponseAttributes{
+				reply.WithHeaders(map[string]string{"Link": "</users?page=1>; rel=\"first\""}),
+				reply.WithHeaderValues(http.Header{"Content-Type": {"application/vnd.api+json"}, "Link": {"</users?page=3>; rel=\"next\""}}),
+				reply.WithHeaderValues(http.Header{"Link": {"</users?page=2>; rel=\"next\"", "</users?page=5>; rel=\"last\""}}),
+			},
+			expectedHeaders: http.Header{
+				"Content-Length": {"14"},
+				"Content-Type":   {"application/vnd.api+json"},
+				"Link":           {"</users?page=2>; rel=\"next\"", "</users?page=5>; rel=\"last\""},
+			},
+		},
+		{
+			name: "Success - Values added to headers already set",
+			attributes: []reply.ResponseAttributes{
+				reply.WithHeaders(map[string]string{"Link": "</users?page=1>; rel=\"first\""}),
+				reply.WithAddedHeaders(http.Header{"Link": {"</users?page=2>; rel=\"next\""}}),
+				reply.WithAddedHeaders(http.Header{"link": {"</users?page=5>; rel=\"last\""}}),
+			},
+			expectedHeaders: http.Header{
+				"Content-Length": {"14"},
+				"Content-Type":   {"application/json"},
+				"Link":           {"</users?page=1>; rel=\"first\"", "</users?page=2>; rel=\"next\"", "</users?page=5>; rel=\"last\""},
+			},
+		},
+		{
+			name:    "Success - Vary values kept alongside CORS",
+			options: []reply.Option{reply.WithCORS(reply.CORSPolicy{Origins: []string{"*"}})},
+			origin:  "https://example.com",
+			attributes: []reply.ResponseAttributes{
+				reply.WithAddedHeaders(http.Header{"Vary": {"Accept-Language"}}),
+			},
+			expectedHeaders: http.Header{
+				"Access-Control-Allow-Origin": {"*"},
+				"Content-Length":              {"14"},
+				"Content-Type":                {"application/json"},
+				"Vary":                        {"Accept-Language", "Origin"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			attributes := append([]reply.ResponseAttributes{reply.WithOrigin(test.origin)}, test.attributes...)
+
+			err := replier.NewHTTPBlankResponse(w, http.StatusOK, attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedHeaders, w.Header())
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"html/template"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	// htmlContentType is the content type of HTML error pages
+	htmlContentType = "text/html; charset=utf-8"
+)
+
+// StatusClass is used to group status codes by their first digit
+type StatusClass int
+
+const (
+	// StatusClassSuccess represents 2xx status codes
+	StatusClassSuccess StatusClass = 2
+
+	// StatusClassClientError represents 4xx status codes
+	StatusClassClientError StatusClass = 4
+
+	// StatusClassServerError represents 5xx status codes
+	StatusClassServerError StatusClass = 5
+)
+
+// HTMLErrorMode is used to define when errors are rendered as HTML pages
+type HTMLErrorMode int
+
+const (
+	// HTMLErrorModeNegotiate renders errors as HTML pages when the request's
+	// `Accept` header prefers `text/html` over `application/json`
+	HTMLErrorModeNegotiate HTMLErrorMode = iota
+
+	// HTMLErrorModeAlways renders errors as HTML pages whenever a template
+	// is registered for the status class
+	HTMLErrorModeAlways
+
+	// HTMLErrorModeNever always renders errors using the transfer object
+	HTMLErrorModeNever
+)
+
+// HTMLError holds the attributes of a single error made available to
+// HTML error page templates
+type HTMLError struct {
+	Title  string
+	Detail string
+	About  string
+	Code   string
+	Status string
+	Meta   interface{}
+}
+
+// HTMLErrorPage holds the data passed to HTML error page templates. Title,
+// Detail, About and Code are taken from the first error.
+type HTMLErrorPage struct {
+	StatusCode int
+	StatusText string
+	Title      string
+	Detail     string
+	About      string
+	Code       string
+	Errors     []HTMLError
+}
+
+// WithHTMLErrorTemplate registers the template used to render error pages for
+// the passed status class, using the same manifest titles and details as
+// the transfer object
+func WithHTMLErrorTemplate(class StatusClass, tmpl *template.Template) Option {
+	return func(r *Replier) {
+		if r.htmlErrorTemplates == nil {
+			r.htmlErrorTemplates = map[StatusClass]*template.Template{}
+		}
+		r.htmlErrorTemplates[class] = tmpl
+	}
+}
+
+// WithHTMLErrorMode sets when errors are rendered as HTML pages, defaults to
+// HTMLErrorModeNegotiate
+func WithHTMLErrorMode(mode HTMLErrorMode) Option {
+	return func(r *Replier) {
+		r.htmlErrorMode = mode
+	}
+}
+
+// WithAccept adds the request's `Accept` header value, used to negotiate
+// whether errors are rendered as HTML pages
+func WithAccept(accept string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Accept = accept
+	}
+}
+
+// shouldRenderHTMLError returns whether the error response should be rendered as
+// an HTML page
+func (r *Replier) shouldRenderHTMLError(response *NewResponseRequest, statusCode int) bool {
+
+	if _, ok := r.htmlErrorTemplates[getStatusClass(statusCode)]; !ok {
+		return false
+	}
+
+	switch r.htmlErrorMode {
+	case HTMLErrorModeAlways:
+		return true
+	case HTMLErrorModeNever:
+		return false
+	}
+
+	return response != nil && prefersHTML(response.Accept)
+}
+
+// sendHTMLErrorResponse renders the errors using the status class's template. If
+// the template fails, the errors are sent using the transfer object.
+func (r *Replier) sendHTMLErrorResponse(response *NewResponseRequest, statusCode int, transferObjectErrors []TransferObjectError) error {
+
+	page := HTMLErrorPage{
+		StatusCode: statusCode,
+		StatusText: http.StatusText(statusCode),
+	}
+
+	for _, transferObjectError := range transferObjectErrors {
+		page.Errors = append(page.Errors, HTMLError{
+			Title:  transferObjectError.GetTitle(),
+			Detail: transferObjectError.GetDetail(),
+			About:  transferObjectError.GetAbout(),
+			Code:   transferObjectError.GetCode(),
+			Status: transferObjectError.GetStatusCode(),
+			Meta:   transferObjectError.GetMeta(),
+		})
+	}
+
+	if len(page.Errors) > 0 {
+		page.Title = page.Errors[0].Title
+		page.Detail = page.Errors[0].Detail
+		page.About = page.Errors[0].About
+		page.Code = page.Errors[0].Code
+	}
+
+	body := getBuffer()
+	defer putBuffer(body)
+
+	if err := r.htmlErrorTemplates[getStatusClass(statusCode)].Execute(body, page); err != nil {
+		log.Printf("reply/html-error: failed to render error page with %v", err)
+		return r.sendHTTPResponse(response)
+	}
+
+	writer := response.transferObject.GetWriter()
+	writer.Header().Set("Content-Type", htmlContentType)
+	setContentLength(writer, body.Len())
+	writer.WriteHeader(statusCode)
+
+	_, err := body.WriteTo(writer)
+
+	return err
+}
+
+// prefersHTML returns whether the `Accept` header value lists `text/html` before
+// (or without) `application/json`
+func prefersHTML(accept string) bool {
+	for _, mediaType := range parseQualityValues(accept) {
+		switch strings.ToLower(mediaType) {
+		case "text/html", "application/xhtml+xml":
+			return true
+		case "application/json", "application/*":
+			return false
+		}
+	}
+
+	return false
+}
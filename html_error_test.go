@@ -0,0 +1,89 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_HTMLErrorPages(t *testing.T) {
+
+	clientErrorTemplate := template.Must(template.New("4xx").Parse(`<h1>{{.StatusCode}} {{.Title}}</h1>{{range .Errors}}<p>{{.Detail}}</p>{{end}}`))
+
+	tests := []struct {
+		name                string
+		options             []reply.Option
+		accept              string
+		err                 error
+		expectedBody        string
+		expectedContentType string
+		expectedStatusCode  int
+	}{
+		{
+			name:                "Success - Browser receives HTML error page",
+			accept:              "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			err:                 errors.New("example-name-validation-error"),
+			expectedBody:        `<h1>400 Validation Error</h1><p>The name provided does not meet validation requirements</p>`,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name:                "Success - API client receives JSON",
+			accept:              "application/json",
+			err:                 getExampleErrorOne(),
+			expectedBody:        stringWithNewLine(getErrorResponseForExampleErrorOne()),
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusNotFound,
+		},
+		{
+			name:                "Success - Status class without template receives JSON",
+			accept:              "text/html",
+			err:                 errors.New("example-missing-error"),
+			expectedBody:        stringWithNewLine(getErrorResponseISEBody()),
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusInternalServerError,
+		},
+		{
+			name:                "Success - Always mode ignores Accept header",
+			options:             []reply.Option{reply.WithHTMLErrorMode(reply.HTMLErrorModeAlways)},
+			err:                 getExampleErrorOne(),
+			expectedBody:        `<h1>404 Resource Not Found</h1><p></p>`,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedStatusCode:  http.StatusNotFound,
+		},
+		{
+			name:                "Success - Never mode ignores Accept header",
+			options:             []reply.Option{reply.WithHTMLErrorMode(reply.HTMLErrorModeNever)},
+			accept:              "text/html",
+			err:                 getExampleErrorOne(),
+			expectedBody:        stringWithNewLine(getErrorResponseForExampleErrorOne()),
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			options := append([]reply.Option{reply.WithHTMLErrorTemplate(reply.StatusClassClientError, clientErrorTemplate)}, test.options...)
+			replier := reply.NewReplier(getDefaultErrorManifest(), options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err, reply.WithAccept(test.accept))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, test.expectedBody, w.Body.String())
+		})
+	}
+}
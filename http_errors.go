@@ -0,0 +1,167 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrMethodNotAllowed is returned by NewHTTPMethodNotAllowedResponse, its
+	// manifest item can be replaced using the `method-not-allowed` manifest key
+	ErrMethodNotAllowed error = &defaultItemError{
+		key:  "method-not-allowed",
+		item: ErrorManifestItem{Title: "Method Not Allowed", StatusCode: http.StatusMethodNotAllowed},
+	}
+
+	// ErrNotFound is used for requests that do not match a route, its manifest
+	// item can be replaced using the `not-found` manifest key
+	ErrNotFound error = &defaultItemError{
+		key:  "not-found",
+		item: ErrorManifestItem{Title: "Not Found", StatusCode: http.StatusNotFound},
+	}
+
+	// ErrUnauthorized is returned by NewHTTPUnauthorizedResponse, its manifest
+	// item can be replaced using the `unauthorized` manifest key
+	ErrUnauthorized error = &defaultItemError{
+		key:  "unauthorized",
+		item: ErrorManifestItem{Title: "Unauthorized", StatusCode: http.StatusUnauthorized},
+	}
+)
+
+// defaultItemError is an error whose manifest item is only used when the error
+// manifest does not hold an entry for it
+type defaultItemError struct {
+	key  string
+	item ErrorManifestItem
+}
+
+// Error returns the error's manifest key
+func (e *defaultItemError) Error() string {
+	return e.key
+}
+
+// NewErrorWithDefaultItem returns an error resolved using the passed manifest
+// key, whose passed manifest item is only used when the error manifest does
+// not hold an entry for the key
+func NewErrorWithDefaultItem(key string, item ErrorManifestItem) error {
+	return &defaultItemError{key: key, item: item}
+}
+
+// itemError is an error resolved to its manifest item, see
+// NewHTTPErrorResponseFromItem
+type itemError struct {
+	item ErrorManifestItem
+}
+
+// Error returns the manifest item's title
+func (e *itemError) Error() string {
+	return e.item.Title
+}
+
+// ErrorManifestItem returns the error's manifest item
+func (e *itemError) ErrorManifestItem() ErrorManifestItem {
+	return e.item
+}
+
+// getDefaultItemErrorItem returns the default manifest item of the error (or an
+// error in its chain), and whether one was found
+func getDefaultItemErrorItem(err error) (ErrorManifestItem, bool) {
+	var itemErr *defaultItemError
+	if !errors.As(err, &itemErr) {
+		return ErrorManifestItem{}, false
+	}
+
+	return itemErr.item, true
+}
+
+// NewHTTPMethodNotAllowedResponse this response aide is used to create a
+// `405 - Method Not Allowed` error response, with the `Allow` header listing
+// the passed allowed methods
+func (r *Replier) NewHTTPMethodNotAllowedResponse(w http.ResponseWriter, allowed []string, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  ErrMethodNotAllowed,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	setResponseHeader(&request, "Allow", strings.Join(allowed, ", "))
+
+	return r.NewHTTPResponse(&request)
+}
+
+// NewHTTPStatusResponse this response aide is used to create an error response
+// for a one-off status code (i.e. `410`, `418` or `451`) without adding it to
+// the error manifest. The error's title is the status code's text, see
+// `http.StatusText`.
+//
+// The item can still be replaced using the `http-<status code>` manifest key
+// (i.e. `http-410`).
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - An InvalidStatusCodeError is returned for status codes outside of the
+// range 100 - 599
+func (r *Replier) NewHTTPStatusResponse(w http.ResponseWriter, statusCode int, attributes ...ResponseAttributes) error {
+
+	if !isValidStatusCode(statusCode) {
+		return &InvalidStatusCodeError{StatusCode: statusCode}
+	}
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error: NewErrorWithDefaultItem(fmt.Sprintf("http-%d", statusCode), ErrorManifestItem{
+			Title:      http.StatusText(statusCode),
+			StatusCode: statusCode,
+		}),
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// NewHTTPErrorResponseFromItem this response aide is used to create an error
+// response from the passed manifest item, so dynamically built items (i.e. from
+// an upstream service's error) can be sent without registering a manifest key.
+//
+// The item is used as is, the error manifest is not used. Items without a status
+// code use the replier's default error status code.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - An InvalidStatusCodeError is returned for item status codes outside of
+// the range 100 - 599
+func (r *Replier) NewHTTPErrorResponseFromItem(w http.ResponseWriter, item ErrorManifestItem, attributes ...ResponseAttributes) error {
+
+	if item.StatusCode != 0 && !isValidStatusCode(item.StatusCode) {
+		return &InvalidStatusCodeError{StatusCode: item.StatusCode}
+	}
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  &itemError{item: item},
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPMethodNotAllowedResponse(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		manifests     []reply.ErrorManifest
+		allowed       []string
+		expectedAllow string
+		expectedBody  string
+	}{
+		{
+			name:          "Success - Default manifest item",
+			manifests:     getEmptyErrorManifest(),
+			allowed:       []string{http.MethodGet, http.MethodPost},
+			expectedAllow: "GET, POST",
+			expectedBody:  `{"errors":[{"title":"Method Not Allowed","status":"405"}]}`,
+		},
+		{
+			name: "Success - Manifest item overrides default",
+			manifests: []reply.ErrorManifest{
+				{"method-not-allowed": reply.ErrorManifestItem{Title: "Method Not Allowed", Detail: "Use one of the allowed methods", Code: "HTTP-405", StatusCode: http.StatusMethodNotAllowed}},
+			},
+			allowed:       []string{http.MethodGet},
+			expectedAllow: "GET",
+			expectedBody:  `{"errors":[{"title":"Method Not Allowed","detail":"Use one of the allowed methods","status":"405","code":"HTTP-405"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPMethodNotAllowedResponse(w, test.allowed)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+			assert.Equal(t, test.expectedAllow, w.Header().Get("Allow"))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestNewErrorWithDefaultItem(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		manifests    []reply.ErrorManifest
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success - Default item used without manifest entry",
+			manifests:    getEmptyErrorManifest(),
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"errors":[{"title":"Conflict","status":"409"}]}`,
+		},
+		{
+			name: "Success - Manifest entry takes precedence",
+			manifests: []reply.ErrorManifest{
+				{"http-409": reply.ErrorManifestItem{Title: "Already Exists", Code: "C409", StatusCode: http.StatusConflict}},
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"errors":[{"title":"Already Exists","status":"409","code":"C409"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPErrorResponse(w, reply.NewErrorWithDefaultItem("http-409", reply.ErrorManifestItem{Title: "Conflict", StatusCode: http.StatusConflict}))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_NewHTTPStatusResponse(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		manifests    []reply.ErrorManifest
+		statusCode   int
+		expectedErr  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success - Status text used without manifest entry",
+			manifests:    getEmptyErrorManifest(),
+			statusCode:   http.StatusGone,
+			expectedCode: http.StatusGone,
+			expectedBody: `{"errors":[{"title":"Gone","status":"410"}]}`,
+		},
+		{
+			name:         "Success - Non-error status code",
+			manifests:    getEmptyErrorManifest(),
+			statusCode:   http.StatusTeapot,
+			expectedCode: http.StatusTeapot,
+			expectedBody: `{"errors":[{"title":"I'm a teapot","status":"418"}]}`,
+		},
+		{
+			name: "Success - Manifest entry takes precedence",
+			manifests: []reply.ErrorManifest{
+				{"http-451": reply.ErrorManifestItem{Title: "Blocked In Your Region", Code: "L451", StatusCode: http.StatusUnavailableForLegalReasons}},
+			},
+			statusCode:   http.StatusUnavailableForLegalReasons,
+			expectedCode: http.StatusUnavailableForLegalReasons,
+			expectedBody: `{"errors":[{"title":"Blocked In Your Region","status":"451","code":"L451"}]}`,
+		},
+		{
+			name:         "Failure - Invalid status code",
+			manifests:    getEmptyErrorManifest(),
+			statusCode:   999,
+			expectedErr:  &reply.InvalidStatusCodeError{StatusCode: 999},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPStatusResponse(w, test.statusCode)
+
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedCode, w.Code)
+			if test.expectedBody != "" {
+				assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			}
+		})
+	}
+}
+
+func TestReplier_NewHTTPErrorResponseFromItem(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		item         reply.ErrorManifestItem
+		attributes   []reply.ResponseAttributes
+		expectedCode int
+		expectedBody string
+		expectedErr  error
+	}{
+		{
+			name:         "Success - Item sent as is",
+			item:         reply.ErrorManifestItem{Title: "Upstream Unavailable", Detail: "The payments service is unavailable", Code: "UP503", StatusCode: http.StatusServiceUnavailable},
+			expectedCode: http.StatusServiceUnavailable,
+			expectedBody: `{"errors":[{"title":"Upstream Unavailable","detail":"The payments service is unavailable","status":"503","code":"UP503"}]}`,
+		},
+		{
+			name:         "Success - Item takes precedence over manifest entry with same title",
+			item:         reply.ErrorManifestItem{Title: "example-404-error", StatusCode: http.StatusConflict},
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"errors":[{"title":"example-404-error","status":"409"}]}`,
+		},
+		{
+			name:         "Success - Item without status code uses default",
+			item:         reply.ErrorManifestItem{Title: "Upstream Rejected"},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"errors":[{"title":"Upstream Rejected","status":"400"}]}`,
+		},
+		{
+			name:         "Success - Item with error meta",
+			item:         reply.ErrorManifestItem{Title: "Upstream Rejected", StatusCode: http.StatusBadGateway},
+			attributes:   []reply.ResponseAttributes{reply.WithErrorMeta(map[string]interface{}{"upstream": "payments"})},
+			expectedCode: http.StatusBadGateway,
+			expectedBody: `{"errors":[{"title":"Upstream Rejected","status":"502","meta":{"upstream":"payments"}}]}`,
+		},
+		{
+			name:         "Failure - Item with invalid status code",
+			item:         reply.ErrorManifestItem{Title: "Upstream Rejected", StatusCode: 42},
+			expectedCode: http.StatusOK,
+			expectedErr:  &reply.InvalidStatusCodeError{StatusCode: 42},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			err := replier.NewHTTPErrorResponseFromItem(w, test.item, test.attributes...)
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				assert.Equal(t, test.expectedCode, w.Code)
+				assert.Empty(t, w.Body.String())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// idempotencyKeyHeader is the header the request's idempotency key is
+	// echoed with
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	// idempotentReplayedMetaKey is the meta key stating whether the response
+	// was replayed from the replier's idempotency store
+	idempotentReplayedMetaKey = "idempotent_replayed"
+)
+
+// IdempotentResponse holds a previously sent response, kept by an idempotency
+// store so it can be replayed
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyStore outlines the methods an idempotency store must implement to
+// keep and replay responses by their request's idempotency key
+type IdempotencyStore interface {
+	// Load returns the response previously sent for the key, if any
+	Load(ctx context.Context, key string) (*IdempotentResponse, bool)
+
+	// Save keeps the response sent for the key
+	Save(ctx context.Context, key string, response IdempotentResponse)
+}
+
+// WithIdempotencyStore sets the store used to keep responses sent with an
+// idempotency key (see WithIdempotencyKey), so repeated requests are replayed
+// the previously sent response rather than a new one.
+//
+// NOTE - 5xx responses are not saved, so the request can be retried
+func WithIdempotencyStore(store IdempotencyStore) Option {
+	return func(r *Replier) {
+		r.idempotencyStore = store
+	}
+}
+
+// WithIdempotencyKey sets the request's `Idempotency-Key` header value, which
+// is echoed on the response. The response's meta states whether it was replayed
+// from the replier's idempotency store with `idempotent_replayed`.
+//
+// NOTE - An empty key is ignored
+func WithIdempotencyKey(key string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.IdempotencyKey = key
+	}
+}
+
+// replayIdempotentResponse sends the response previously sent for the response's
+// idempotency key, if the replier's idempotency store has one, returning whether
+// it was replayed
+func (r *Replier) replayIdempotentResponse(response *NewResponseRequest) (bool, error) {
+	if r.idempotencyStore == nil {
+		return false, nil
+	}
+
+	stored, ok := r.idempotencyStore.Load(getResponseContext(response), response.IdempotencyKey)
+	if !ok || stored == nil {
+		return false, nil
+	}
+
+	header := response.Writer.Header()
+	for key, values := range stored.Header {
+		header[key] = append([]string(nil), values...)
+	}
+	header.Set(idempotencyKeyHeader, response.IdempotencyKey)
+
+	body := r.stampReplayedBody(stored.Body)
+
+	statusCode := stored.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	setContentLength(response.Writer, len(body))
+	response.Writer.WriteHeader(statusCode)
+	if _, err := response.Writer.Write(body); err != nil {
+		return true, fmt.Errorf("reply/http-response: failed to write response with %v", err)
+	}
+
+	return true, nil
+}
+
+// stampReplayedBody returns the stored body with its meta stating it was
+// replayed. Bodies that are not JSON objects are returned as stored.
+func (r *Replier) stampReplayedBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document map[string]interface{}
+	if err := decoder.Decode(&document); err != nil || document == nil {
+		return body
+	}
+
+	keys := r.getEnvelopeKeys()
+
+	meta, _ := document[keys.Meta].(map[string]interface{})
+	document[keys.Meta] = mergeMeta(meta, map[string]interface{}{idempotentReplayedMetaKey: true})
+
+	stamped := &bytes.Buffer{}
+	if err := r.encoder.Encode(stamped, document); err != nil {
+		return body
+	}
+
+	return stamped.Bytes()
+}
+
+// prepareIdempotentResponse echoes the response's idempotency key and stamps its
+// meta as not replayed, returning a function that saves the sent response in the
+// replier's idempotency store, if set
+func (r *Replier) prepareIdempotentResponse(response *NewResponseRequest) func() {
+	response.Writer.Header().Set(idempotencyKeyHeader, response.IdempotencyKey)
+	response.Meta = mergeMeta(response.Meta, map[string]interface{}{idempotentReplayedMetaKey: false})
+
+	if r.idempotencyStore == nil {
+		return func() {}
+	}
+
+	writer := &idempotencyResponseWriter{ResponseWriter: response.Writer}
+	response.Writer = writer
+
+	return func() {
+		response.Writer = writer.ResponseWriter
+
+		if writer.statusCode == 0 || writer.statusCode >= http.StatusInternalServerError {
+			return
+		}
+
+		r.idempotencyStore.Save(getResponseContext(response), response.IdempotencyKey, IdempotentResponse{
+			StatusCode: writer.statusCode,
+			Header:     writer.Header().Clone(),
+			Body:       writer.body.Bytes(),
+		})
+	}
+}
+
+// idempotencyResponseWriter wraps a http.ResponseWriter, keeping a copy of the
+// status code and body written so they can be saved
+type idempotencyResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+// WriteHeader records the status code before writing it
+func (w *idempotencyResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 && !isInformational(statusCode) {
+		w.statusCode = statusCode
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write keeps a copy of the body before writing it
+func (w *idempotencyResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	w.body.Write(b)
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush flushes the wrapped writer, if it implements `http.Flusher`
+func (w *idempotencyResponseWriter) Flush() {
+	flushWriter(w.ResponseWriter)
+}
+
+// Unwrap returns the wrapped writer, used by `http.ResponseController`
+func (w *idempotencyResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapIdempotencyStore map[string]reply.IdempotentResponse
+
+func (s mapIdempotencyStore) Load(ctx context.Context, key string) (*reply.IdempotentResponse, bool) {
+	response, ok := s[key]
+	return &response, ok
+}
+
+func (s mapIdempotencyStore) Save(ctx context.Context, key string, response reply.IdempotentResponse) {
+	s[key] = response
+}
+
+func TestReplier_WithIdempotencyKey(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		key                string
+		expectedKeyHeader  string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Key echoed and meta stamped",
+			key:                "key-1",
+			expectedKeyHeader:  "key-1",
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"data":{"name":"john doe"},"meta":{"idempotent_replayed":false}}`,
+		},
+		{
+			name:               "Success - Empty key ignored",
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"data":{"name":"john doe"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{})
+
+			err := replier.NewHTTPDataResponse(w, http.StatusCreated, map[string]string{"name": "john doe"},
+				reply.WithIdempotencyKey(test.key),
+			)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedKeyHeader, w.Header().Get("Idempotency-Key"))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_WithIdempotencyStore(t *testing.T) {
+	store := mapIdempotencyStore{}
+	replier := reply.NewReplier([]reply.ErrorManifest{}, reply.WithIdempotencyStore(store))
+
+	first := httptest.NewRecorder()
+	err := replier.NewHTTPDataResponse(first, http.StatusCreated, map[string]string{"name": "john doe"},
+		reply.WithIdempotencyKey("key-1"),
+		reply.WithHeaders(map[string]string{"X-Resource": "users"}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, stringWithNewLine(`{"data":{"name":"john doe"},"meta":{"idempotent_replayed":false}}`), first.Body.String())
+
+	replayed := httptest.NewRecorder()
+	err = replier.NewHTTPDataResponse(replayed, http.StatusCreated, map[string]string{"name": "jane doe"},
+		reply.WithIdempotencyKey("key-1"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, replayed.Code)
+	assert.Equal(t, "key-1", replayed.Header().Get("Idempotency-Key"))
+	assert.Equal(t, "users", replayed.Header().Get("X-Resource"))
+	assert.Equal(t, "application/json", replayed.Header().Get("Content-Type"))
+	assert.Equal(t, stringWithNewLine(`{"data":{"name":"john doe"},"meta":{"idempotent_replayed":true}}`), replayed.Body.String())
+	assert.Equal(t, strconv.Itoa(replayed.Body.Len()), replayed.Header().Get("Content-Length"))
+
+	other := httptest.NewRecorder()
+	err = replier.NewHTTPDataResponse(other, http.StatusCreated, map[string]string{"name": "jane doe"},
+		reply.WithIdempotencyKey("key-2"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, stringWithNewLine(`{"data":{"name":"jane doe"},"meta":{"idempotent_replayed":false}}`), other.Body.String())
+}
+
+func TestReplier_WithIdempotencyStoreSkipsServerErrors(t *testing.T) {
+	store := mapIdempotencyStore{}
+	replier := reply.NewReplier([]reply.ErrorManifest{}, reply.WithIdempotencyStore(store))
+
+	w := httptest.NewRecorder()
+	err := replier.NewHTTPErrorResponse(w, errors.New("unknown"), reply.WithIdempotencyKey("key-1"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, store)
+}
@@ -0,0 +1,220 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrorManifestItemTranslation holds the translated title and detail of an
+// error manifest item for a single language
+type ErrorManifestItemTranslation struct {
+	Title  string
+	Detail string
+}
+
+// Localizer outlines expected methods of a localizer, used to translate error
+// manifest items into the language negotiated for the response
+type Localizer interface {
+
+	// Languages returns the language tags (i.e. `fr`, `en-GB`) the localizer
+	// supports
+	Languages() []string
+
+	// Localize returns the manifest item translated into the passed language. The
+	// context is the response request's context.
+	Localize(ctx context.Context, language string, item ErrorManifestItem) ErrorManifestItem
+}
+
+// WithLocalizer sets the localizer used to translate error manifest items. The
+// localizer is used after any translations held on the manifest item.
+func WithLocalizer(localizer Localizer) Option {
+	return func(r *Replier) {
+		r.localizer = localizer
+	}
+}
+
+// WithAcceptLanguage adds the request's `Accept-Language` header value, used to
+// negotiate the language of the response's error(s). When the error(s) are
+// translated into the negotiated language, it is reflected in the response's
+// `Content-Language` header.
+func WithAcceptLanguage(acceptLanguage string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.AcceptLanguage = acceptLanguage
+	}
+}
+
+// localizeErrorManifestItem returns the manifest item translated into the passed
+// language, using its own translations first, then the replier's localizer, and
+// whether a translation was applied.
+//
+// NOTE - The localizer's translation is considered applied when it changes the
+// item's title or detail
+func (r *Replier) localizeErrorManifestItem(ctx context.Context, language string, item ErrorManifestItem) (ErrorManifestItem, bool) {
+
+	if isEmpty(language) {
+		return item, false
+	}
+
+	if translation, ok := getTranslation(item.Translations, language); ok {
+		if !isEmpty(translation.Title) {
+			item.Title = translation.Title
+		}
+		if !isEmpty(translation.Detail) {
+			item.Detail = translation.Detail
+		}
+		return item, true
+	}
+
+	if r.localizer != nil {
+		localized := r.localizer.Localize(ctx, language, item)
+		return localized, localized.Title != item.Title || localized.Detail != item.Detail
+	}
+
+	return item, false
+}
+
+// getTranslation returns the translation matching the language, ignoring case
+func getTranslation(translations map[string]ErrorManifestItemTranslation, language string) (ErrorManifestItemTranslation, bool) {
+	for tag, translation := range translations {
+		if strings.EqualFold(tag, language) {
+			return translation, true
+		}
+	}
+
+	return ErrorManifestItemTranslation{}, false
+}
+
+// negotiateLanguage returns the supported language that best matches the
+// response's `Accept-Language`, or an empty string if none match
+func (r *Replier) negotiateLanguage(response *NewResponseRequest) string {
+
+	if response == nil || isEmpty(response.AcceptLanguage) || len(r.supportedLanguages) == 0 {
+		return ""
+	}
+
+	for _, candidate := range parseQualityValues(response.AcceptLanguage) {
+		if language, ok := r.matchSupportedLanguage(candidate); ok {
+			return language
+		}
+	}
+
+	return ""
+}
+
+// matchSupportedLanguage returns the supported language that matches the
+// candidate, falling back to the candidate's base language (`en-GB` -> `en`)
+func (r *Replier) matchSupportedLanguage(candidate string) (string, bool) {
+
+	if candidate == "*" {
+		return r.supportedLanguages[0], true
+	}
+
+	base := strings.SplitN(candidate, "-", 2)[0]
+
+	for _, tag := range []string{candidate, base} {
+		for _, language := range r.supportedLanguages {
+			if strings.EqualFold(language, tag) {
+				return language, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// getSupportedLanguages returns the languages found in the manifest's translations
+// (including regular expression manifest items) and supported by the localizer,
+// ordered alphabetically
+func (r *Replier) getSupportedLanguages() []string {
+
+	languages := map[string]bool{}
+
+	for _, item := range r.errorManifest {
+		for language := range item.Translations {
+			languages[language] = true
+		}
+	}
+
+	for _, regexpItem := range r.regexpErrorManifest {
+		for language := range regexpItem.Item.Translations {
+			languages[language] = true
+		}
+	}
+
+	if r.localizer != nil {
+		for _, language := range r.localizer.Languages() {
+			languages[language] = true
+		}
+	}
+
+	supportedLanguages := make([]string, 0, len(languages))
+	for language := range languages {
+		supportedLanguages = append(supportedLanguages, language)
+	}
+	sort.Strings(supportedLanguages)
+
+	return supportedLanguages
+}
+
+// parseQualityValues returns the values in a header using quality values, i.e.
+// `Accept-Language` or `Accept`, ordered by their quality (highest first).
+// Values with a quality of `0` are excluded.
+func parseQualityValues(header string) []string {
+
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	weightedTags := []weightedTag{}
+
+	for _, part := range strings.Split(header, ",") {
+		segments := strings.Split(strings.TrimSpace(part), ";")
+
+		tag := strings.TrimSpace(segments[0])
+		if isEmpty(tag) {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range segments[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		weightedTags = append(weightedTags, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(weightedTags, func(i, j int) bool {
+		return weightedTags[i].quality > weightedTags[j].quality
+	})
+
+	tags := make([]string, 0, len(weightedTags))
+	for _, weighted := range weightedTags {
+		tags = append(tags, weighted.tag)
+	}
+
+	return tags
+}
+
+// setContentLanguage sets the `Content-Language` header to the language the
+// response's error(s) were translated into, if any
+func (r *Replier) setContentLanguage(response *NewResponseRequest) {
+	if !isEmpty(response.contentLanguage) {
+		response.transferObject.GetWriter().Header().Set("Content-Language", response.contentLanguage)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// prefixLocalizer example localizer that "translates" items by
+// prefixing them with the language
+type prefixLocalizer struct{}
+
+func (l *prefixLocalizer) Languages() []string {
+	return []string{"de"}
+}
+
+func (l *prefixLocalizer) Localize(ctx context.Context, language string, item reply.ErrorManifestItem) reply.ErrorManifestItem {
+	item.Title = "[" + language + "] " + item.Title
+	return item
+}
+
+func TestReplier_LocalizedErrors(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{
+			Title:      "Resource Not Found",
+			Detail:     "The resource could not be found",
+			StatusCode: http.StatusNotFound,
+			Translations: map[string]reply.ErrorManifestItemTranslation{
+				"fr":    {Title: "Ressource introuvable", Detail: "La ressource est introuvable"},
+				"es-MX": {Title: "Recurso no encontrado"},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name                    string
+		acceptLanguage          string
+		options                 []reply.Option
+		expectedBody            string
+		expectedContentLanguage string
+	}{
+		{
+			name:         "Success - No Accept-Language keeps default",
+			expectedBody: `{"errors":[{"title":"Resource Not Found","detail":"The resource could not be found","status":"404"}]}`,
+		},
+		{
+			name:                    "Success - Translation selected using base language",
+			acceptLanguage:          "fr-CA, en;q=0.8",
+			expectedBody:            `{"errors":[{"title":"Ressource introuvable","detail":"La ressource est introuvable","status":"404"}]}`,
+			expectedContentLanguage: "fr",
+		},
+		{
+			name:                    "Success - Highest quality supported language selected",
+			acceptLanguage:          "fr;q=0.5, es-MX;q=0.9, it",
+			expectedBody:            `{"errors":[{"title":"Recurso no encontrado","detail":"The resource could not be found","status":"404"}]}`,
+			expectedContentLanguage: "es-MX",
+		},
+		{
+			name:           "Success - Unsupported language keeps default",
+			acceptLanguage: "it, fr;q=0",
+			expectedBody:   `{"errors":[{"title":"Resource Not Found","detail":"The resource could not be found","status":"404"}]}`,
+		},
+		{
+			name:                    "Success - Localizer used when item has no translation",
+			acceptLanguage:          "de",
+			options:                 []reply.Option{reply.WithLocalizer(&prefixLocalizer{})},
+			expectedBody:            `{"errors":[{"title":"[de] Resource Not Found","detail":"The resource could not be found","status":"404"}]}`,
+			expectedContentLanguage: "de",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, errors.New("example-404-error"), reply.WithAcceptLanguage(test.acceptLanguage))
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.expectedContentLanguage, w.Header().Get("Content-Language"))
+		})
+	}
+}
+
+// noopLocalizer example localizer that supports a language without
+// translating any items
+type noopLocalizer struct{}
+
+func (l *noopLocalizer) Languages() []string {
+	return []string{"de"}
+}
+
+func (l *noopLocalizer) Localize(ctx context.Context, language string, item reply.ErrorManifestItem) reply.ErrorManifestItem {
+	return item
+}
+
+func TestReplier_LocalizedErrorsUntranslated(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{
+			Title:      "Resource Not Found",
+			StatusCode: http.StatusNotFound,
+			Translations: map[string]reply.ErrorManifestItemTranslation{
+				"fr": {Title: "Ressource introuvable"},
+			},
+		}},
+		{"example-409-error": reply.ErrorManifestItem{Title: "Resource Conflict", StatusCode: http.StatusConflict}},
+	}
+
+	tests := []struct {
+		name                    string
+		acceptLanguage          string
+		options                 []reply.Option
+		errs                    []error
+		expectedStatusCode      int
+		expectedBody            string
+		expectedContentLanguage string
+	}{
+		{
+			name:               "Success - Item without translation keeps default",
+			acceptLanguage:     "fr",
+			errs:               []error{errors.New("example-409-error")},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"errors":[{"title":"Resource Conflict","status":"409"}]}`,
+		},
+		{
+			name:               "Success - Localizer without translation keeps default",
+			acceptLanguage:     "de",
+			options:            []reply.Option{reply.WithLocalizer(&noopLocalizer{})},
+			errs:               []error{errors.New("example-409-error")},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"errors":[{"title":"Resource Conflict","status":"409"}]}`,
+		},
+		{
+			name:                    "Success - Any translated item sets language",
+			acceptLanguage:          "fr",
+			errs:                    []error{errors.New("example-409-error"), errors.New("example-404-error")},
+			expectedStatusCode:      http.StatusConflict,
+			expectedBody:            `{"errors":[{"title":"Resource Conflict","status":"409"},{"title":"Ressource introuvable","status":"404"}]}`,
+			expectedContentLanguage: "fr",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPMultiErrorResponse(w, test.errs, reply.WithAcceptLanguage(test.acceptLanguage))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.expectedContentLanguage, w.Header().Get("Content-Language"))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// manifestWildcardSuffix is the suffix used on manifest keys to match
+	// all errors starting with the rest of the key, i.e. `validation/*`
+	manifestWildcardSuffix = "*"
+)
+
+// ManifestedError outlines an error that supplies its own manifest item. When
+// a passed error (or an error in its chain) implements it, the error manifest
+// is not used to look up the error's manifest item.
+type ManifestedError interface {
+	error
+	ErrorManifestItem() ErrorManifestItem
+}
+
+// RegexpErrorManifestItem holds a compiled regular expression with the manifest
+// item returned for errors it matches.
+//
+// The regular expression's capture groups can be substituted into the item's
+// `Title` and `Detail` using their index (`{1}`) or name (`{id}`), i.e. a
+// pattern of `^user-(\d+)-not-found$` with a detail of
+// `User {1} could not be found`.
+type RegexpErrorManifestItem struct {
+	Pattern *regexp.Regexp
+	Item    ErrorManifestItem
+}
+
+// WithRegexpErrorManifest adds manifest items that are matched using regular
+// expressions rather than the error's exact value. Items are attempted in the
+// order they are passed.
+func WithRegexpErrorManifest(items ...RegexpErrorManifestItem) Option {
+	return func(r *Replier) {
+		for _, item := range items {
+			if item.Pattern == nil {
+				continue
+			}
+			r.regexpErrorManifest = append(r.regexpErrorManifest, item)
+		}
+	}
+}
+
+// lookupErrorManifestItem returns the manifest item that corresponds with the
+// passed key, and whether one was found.
+//
+// Precedence rules:
+//
+// - An exact match on key always wins
+//
+// - Otherwise, the first matching regular expression manifest item is used
+//
+// - Otherwise, the wildcard key (i.e. `validation/*`) with the longest matching
+// prefix is used
+func (r *Replier) lookupErrorManifestItem(key string) (ErrorManifestItem, bool) {
+
+	if manifestItem, ok := r.errorManifest[key]; ok {
+		return manifestItem, true
+	}
+
+	for _, regexpItem := range r.regexpErrorManifest {
+		if matches := regexpItem.Pattern.FindStringSubmatch(key); matches != nil {
+			return substituteCaptureGroups(regexpItem, matches), true
+		}
+	}
+
+	for _, prefix := range r.wildcardPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return r.errorManifest[prefix+manifestWildcardSuffix], true
+		}
+	}
+
+	return ErrorManifestItem{}, false
+}
+
+// getManifestedErrorItem returns the manifest item of the first error in the
+// passed error's chain that implements ManifestedError, and whether one
+// was found
+func getManifestedErrorItem(err error) (ErrorManifestItem, bool) {
+	var manifestedErr ManifestedError
+	if errors.As(err, &manifestedErr) {
+		return manifestedErr.ErrorManifestItem(), true
+	}
+
+	return ErrorManifestItem{}, false
+}
+
+// getWildcardPrefixes returns the prefixes of the manifest's wildcard keys,
+// ordered by length (longest first) then alphabetically so matching is
+// deterministic
+func getWildcardPrefixes(manifest ErrorManifest) []string {
+
+	prefixes := []string{}
+
+	for key := range manifest {
+		if strings.HasSuffix(key, manifestWildcardSuffix) {
+			prefixes = append(prefixes, strings.TrimSuffix(key, manifestWildcardSuffix))
+		}
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		if len(prefixes[i]) != len(prefixes[j]) {
+			return len(prefixes[i]) > len(prefixes[j])
+		}
+		return prefixes[i] < prefixes[j]
+	})
+
+	return prefixes
+}
+
+// substituteCaptureGroups returns the regular expression manifest item with its
+// capture group placeholders in the title and detail replaced by their
+// matched values
+func substituteCaptureGroups(regexpItem RegexpErrorManifestItem, matches []string) ErrorManifestItem {
+
+	replacements := []string{}
+	names := regexpItem.Pattern.SubexpNames()
+
+	for i := 1; i < len(matches); i++ {
+		replacements = append(replacements, "{"+strconv.Itoa(i)+"}", matches[i])
+
+		if !isEmpty(names[i]) {
+			replacements = append(replacements, "{"+names[i]+"}", matches[i])
+		}
+	}
+
+	replacer := strings.NewReplacer(replacements...)
+
+	manifestItem := regexpItem.Item
+	manifestItem.Title = replacer.Replace(manifestItem.Title)
+	manifestItem.Detail = replacer.Replace(manifestItem.Detail)
+
+	return manifestItem
+}
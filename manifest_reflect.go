@@ -0,0 +1,142 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// manifestTagName is the name of the struct tag describing an error's
+	// manifest item, see ManifestFromErrors
+	manifestTagName = "reply"
+)
+
+// errorType is the reflected type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ManifestFromErrors builds a manifest from the exported error fields of the
+// passed structs (or pointers to structs), so errors and their response details
+// can be declared side by side, i.e.
+//
+//	var Errors = struct {
+//		UserNotFound error `reply:"status=404,code=E404,title=User Not Found"`
+//	}{
+//		UserNotFound: errors.New("user not found"),
+//	}
+//
+// Each field's error is keyed by its value, with its item described by the
+// field's `reply` tag. The tag supports the `title`, `detail`, `status`, `code`,
+// `about` and `severity` attributes. Commas that are not followed by an
+// attribute are kept in the value, i.e. `title=Oops, try again`.
+//
+// NOTE - Fields without a `reply` tag are skipped, and an error is returned for
+// tagged fields that are not set, or with invalid tags
+func ManifestFromErrors(errs ...interface{}) (ErrorManifest, error) {
+
+	manifest := make(ErrorManifest)
+
+	for _, definitions := range errs {
+		value := reflect.ValueOf(definitions)
+		for value.Kind() == reflect.Ptr && !value.IsNil() {
+			value = value.Elem()
+		}
+
+		if value.Kind() != reflect.Struct {
+			return manifest, fmt.Errorf("reply/manifest: failed to build manifest, %T is not a struct", definitions)
+		}
+
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+
+			tag, ok := field.Tag.Lookup(manifestTagName)
+			if !ok || !field.IsExported() || !field.Type.Implements(errorType) {
+				continue
+			}
+
+			fieldValue := value.Field(i)
+			if isNilField(fieldValue) {
+				return manifest, fmt.Errorf("reply/manifest: failed to build manifest, field %s is not set", field.Name)
+			}
+
+			item, tagErr := parseManifestTag(tag)
+			if tagErr != nil {
+				return manifest, fmt.Errorf("reply/manifest: failed to build manifest, field %s has %w", field.Name, tagErr)
+			}
+
+			manifest[fieldValue.Interface().(error).Error()] = item
+		}
+	}
+
+	return manifest, nil
+}
+
+// isNilField returns whether the field holds a nil value
+func isNilField(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	}
+
+	return false
+}
+
+// parseManifestTag returns the manifest item described by the `reply` tag
+func parseManifestTag(tag string) (ErrorManifestItem, error) {
+
+	var item ErrorManifestItem
+
+	for _, attribute := range splitManifestTag(tag) {
+		name, value, _ := strings.Cut(attribute, "=")
+
+		switch strings.TrimSpace(name) {
+		case "title":
+			item.Title = value
+		case "detail":
+			item.Detail = value
+		case "about":
+			item.About = value
+		case "code":
+			item.Code = value
+		case "severity":
+			item.Severity = Severity(value)
+		case "status":
+			statusCode, err := strconv.Atoi(value)
+			if err != nil || !isValidStatusCode(statusCode) {
+				return item, fmt.Errorf("invalid status %q", value)
+			}
+			item.StatusCode = statusCode
+		default:
+			return item, fmt.Errorf("unknown attribute %q", name)
+		}
+	}
+
+	return item, nil
+}
+
+// splitManifestTag splits the tag into its attributes, keeping commas that are
+// not followed by an attribute in the preceding attribute's value
+func splitManifestTag(tag string) []string {
+
+	var attributes []string
+
+	for _, part := range strings.Split(tag, ",") {
+		if len(attributes) > 0 && !strings.Contains(part, "=") {
+			attributes[len(attributes)-1] += "," + part
+			continue
+		}
+
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+
+		attributes = append(attributes, part)
+	}
+
+	return attributes
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestFromErrors(t *testing.T) {
+
+	userErrors := struct {
+		NotFound   error `reply:"status=404,code=E404,title=User Not Found"`
+		Conflict   error `reply:"status=409,title=User Exists,detail=Sorry, the user already exists,severity=warn"`
+		Untagged   error
+		unexported error `reply:"status=500"`
+	}{
+		NotFound:   errors.New("user not found"),
+		Conflict:   errors.New("user exists"),
+		Untagged:   errors.New("untagged"),
+		unexported: errors.New("unexported"),
+	}
+
+	tests := []struct {
+		name             string
+		errs             []interface{}
+		expectedManifest reply.ErrorManifest
+		expectedErr      string
+	}{
+		{
+			name: "Success - Manifest built from tagged fields",
+			errs: []interface{}{&userErrors},
+			expectedManifest: reply.ErrorManifest{
+				"user not found": reply.ErrorManifestItem{Title: "User Not Found", StatusCode: http.StatusNotFound, Code: "E404"},
+				"user exists":    reply.ErrorManifestItem{Title: "User Exists", Detail: "Sorry, the user already exists", StatusCode: http.StatusConflict, Severity: reply.SeverityWarn},
+			},
+		},
+		{
+			name:        "Failure - Not a struct",
+			errs:        []interface{}{"user not found"},
+			expectedErr: "reply/manifest: failed to build manifest, string is not a struct",
+		},
+		{
+			name: "Failure - Field not set",
+			errs: []interface{}{struct {
+				NotFound error `reply:"status=404"`
+			}{}},
+			expectedErr: "reply/manifest: failed to build manifest, field NotFound is not set",
+		},
+		{
+			name: "Failure - Invalid status",
+			errs: []interface{}{struct {
+				NotFound error `reply:"status=four"`
+			}{NotFound: errors.New("not found")}},
+			expectedErr: `reply/manifest: failed to build manifest, field NotFound has invalid status "four"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := reply.ManifestFromErrors(test.errs...)
+
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedManifest, manifest)
+		})
+	}
+}
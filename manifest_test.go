@@ -0,0 +1,221 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WildcardManifestMatching(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"validation/*": reply.ErrorManifestItem{Title: "Validation Error", StatusCode: http.StatusBadRequest}},
+		{"validation/address/*": reply.ErrorManifestItem{Title: "Address Validation Error", StatusCode: http.StatusUnprocessableEntity}},
+		{"validation/dob": reply.ErrorManifestItem{Title: "Check your DoB", StatusCode: http.StatusBadRequest, Code: "100YT"}},
+	}
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Wildcard key matches error family",
+			err:                errors.New("validation/name"),
+			expectedBody:       `{"errors":[{"title":"Validation Error","status":"400"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Exact match takes precedence over wildcard",
+			err:                errors.New("validation/dob"),
+			expectedBody:       `{"errors":[{"title":"Check your DoB","status":"400","code":"100YT"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Longest wildcard prefix takes precedence",
+			err:                errors.New("validation/address/postcode"),
+			expectedBody:       `{"errors":[{"title":"Address Validation Error","status":"422"}]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Failure - Unmatched error returns internal server error",
+			err:                errors.New("authorisation/denied"),
+			expectedBody:       getErrorResponseISEBody(),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_RegexpManifestMatching(t *testing.T) {
+
+	regexpItems := []reply.RegexpErrorManifestItem{
+		{
+			Pattern: regexp.MustCompile(`^user-(\d+)-not-found$`),
+			Item:    reply.ErrorManifestItem{Title: "User Not Found", Detail: "User {1} could not be found", StatusCode: http.StatusNotFound},
+		},
+		{
+			Pattern: regexp.MustCompile(`^order-(?P<order>\w+)-item-(?P<item>\w+)-missing$`),
+			Item:    reply.ErrorManifestItem{Title: "Order Item {item} Missing", Detail: "Order {order} does not contain item {2}"},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		err                error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Indexed capture group substituted",
+			manifests:          getEmptyErrorManifest(),
+			err:                errors.New("user-1234-not-found"),
+			expectedBody:       `{"errors":[{"title":"User Not Found","detail":"User 1234 could not be found","status":"404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Success - Named capture groups substituted and default status code set",
+			manifests:          getEmptyErrorManifest(),
+			err:                errors.New("order-a1-item-b2-missing"),
+			expectedBody:       `{"errors":[{"title":"Order Item b2 Missing","detail":"Order a1 does not contain item b2","status":"400"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success - Exact match takes precedence over regular expression",
+			manifests: []reply.ErrorManifest{
+				{"user-1-not-found": reply.ErrorManifestItem{Title: "Admin Not Found", StatusCode: http.StatusGone}},
+			},
+			err:                errors.New("user-1-not-found"),
+			expectedBody:       `{"errors":[{"title":"Admin Not Found","status":"410"}]}`,
+			expectedStatusCode: http.StatusGone,
+		},
+		{
+			name:               "Failure - Unmatched error returns internal server error",
+			manifests:          getEmptyErrorManifest(),
+			err:                errors.New("user-abc-not-found"),
+			expectedBody:       getErrorResponseISEBody(),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(test.manifests, reply.WithRegexpErrorManifest(regexpItems...))
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+// userNotFoundError example of an error that supplies its
+// own manifest item
+type userNotFoundError struct {
+	id string
+}
+
+func (e *userNotFoundError) Error() string {
+	return "user-not-found"
+}
+
+func (e *userNotFoundError) ErrorManifestItem() reply.ErrorManifestItem {
+	return reply.ErrorManifestItem{Title: "User Not Found", Detail: "User " + e.id + " could not be found", StatusCode: http.StatusNotFound, Code: "U404"}
+}
+
+// invalidStatusError example of an error that supplies a manifest
+// item with an invalid status code
+type invalidStatusError struct{}
+
+func (e *invalidStatusError) Error() string {
+	return "invalid-status"
+}
+
+func (e *invalidStatusError) ErrorManifestItem() reply.ErrorManifestItem {
+	return reply.ErrorManifestItem{Title: "Invalid Status", StatusCode: 42}
+}
+
+func TestReplier_ManifestedError(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		err                error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Error supplies its own manifest item",
+			manifests:          getEmptyErrorManifest(),
+			err:                &userNotFoundError{id: "1234"},
+			expectedBody:       `{"errors":[{"title":"User Not Found","detail":"User 1234 could not be found","status":"404","code":"U404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Success - Wrapped error supplies its own manifest item",
+			manifests:          getEmptyErrorManifest(),
+			err:                fmt.Errorf("handler failed: %w", &userNotFoundError{id: "5678"}),
+			expectedBody:       `{"errors":[{"title":"User Not Found","detail":"User 5678 could not be found","status":"404","code":"U404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Success - Supplied manifest item takes precedence over manifest",
+			manifests: []reply.ErrorManifest{
+				{"user-not-found": reply.ErrorManifestItem{Title: "Resource Not Found", StatusCode: http.StatusNotFound}},
+			},
+			err:                &userNotFoundError{id: "1234"},
+			expectedBody:       `{"errors":[{"title":"User Not Found","detail":"User 1234 could not be found","status":"404","code":"U404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Success - Supplied item with invalid status code uses default",
+			manifests:          getEmptyErrorManifest(),
+			err:                &invalidStatusError{},
+			expectedBody:       `{"errors":[{"title":"Invalid Status","status":"400"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(test.manifests)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
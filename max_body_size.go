@@ -0,0 +1,163 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"reflect"
+)
+
+// BodySizePolicy describes how responses whose encoded body exceeds the
+// replier's maximum body size are handled
+type BodySizePolicy int
+
+const (
+	// BodySizeError replaces oversized responses with a `500 - Internal Server
+	// Error` error response
+	BodySizeError BodySizePolicy = iota
+
+	// BodySizeTruncate drops trailing items from oversized slice data until the
+	// response fits, flagging the response with the `truncated` meta key.
+	// Responses that can not be truncated are handled like BodySizeError.
+	BodySizeTruncate
+
+	// BodySizeChunk writes oversized responses in chunks of the maximum body
+	// size, flushing the writer after each chunk
+	BodySizeChunk
+)
+
+const (
+	// truncatedMetaKey is the meta key set on responses whose data was truncated
+	truncatedMetaKey = "truncated"
+)
+
+// BodyTooLargeError is returned when a response's encoded body exceeds the replier's
+// maximum body size. A fallback `500 - Internal Server Error` response will have
+// been sent in its place.
+type BodyTooLargeError struct {
+	Size    int
+	MaxSize int
+}
+
+// Error returns the size of the oversized body
+func (e *BodyTooLargeError) Error() string {
+	return fmt.Sprintf("reply/http-response: failed to send response, body of %d bytes exceeds maximum of %d bytes", e.Size, e.MaxSize)
+}
+
+// WithMaxBodySize sets the maximum size, in bytes, of encoded response bodies,
+// and how responses exceeding it are handled. Protects clients from being sent
+// very large structures by accident.
+//
+// NOTE:
+//
+// - Bodies are fully encoded into memory before their size is checked, so the
+// option does not limit the memory used to build responses
+//
+// - Only truncates the data of data responses with slice data, sent using the
+// transfer object envelope. Truncated responses are encoded once for each step
+// of a binary search over the data's items
+func WithMaxBodySize(n int, policy BodySizePolicy) Option {
+	return func(r *Replier) {
+		r.maxBodySize = n
+		r.bodySizePolicy = policy
+	}
+}
+
+// isBodyOversized returns whether the encoded body exceeds the replier's maximum
+// body size, if set
+func (r *Replier) isBodyOversized(body *bytes.Buffer) bool {
+	return r.maxBodySize > 0 && body.Len() > r.maxBodySize
+}
+
+// writeOversizedHTTPResponse writes the oversized body in chunks if permitted by
+// the replier's body size policy, otherwise a fallback error response is sent
+func (r *Replier) writeOversizedHTTPResponse(response *NewResponseRequest, statusCode int, body *bytes.Buffer) error {
+
+	if r.bodySizePolicy != BodySizeChunk {
+		log.Printf("reply/http-response: body of %d bytes exceeds maximum of %d bytes, sending fallback error response", body.Len(), r.maxBodySize)
+
+		r.sendFallbackErrorResponse(response)
+		return &BodyTooLargeError{Size: body.Len(), MaxSize: r.maxBodySize}
+	}
+
+	writer := response.transferObject.GetWriter()
+
+	writer.WriteHeader(statusCode)
+	for chunk := body.Bytes(); len(chunk) > 0; {
+		size := r.maxBodySize
+		if len(chunk) < size {
+			size = len(chunk)
+		}
+
+		if _, err := writer.Write(chunk[:size]); err != nil {
+			return fmt.Errorf("reply/http-response: failed to write response with %v", err)
+		}
+		flushWriter(writer)
+
+		chunk = chunk[size:]
+	}
+
+	r.captureResponse(writer, statusCode, body.Bytes())
+
+	return nil
+}
+
+// truncateTransferObjectData drops trailing items from the transfer object's
+// slice data until the encoded response fits the replier's maximum body size,
+// flagging the response as truncated
+//
+// NOTE - The transfer object is left unchanged if the response already fits, or
+// the data can not be truncated to fit
+func (r *Replier) truncateTransferObjectData(response *NewResponseRequest) {
+
+	if r.maxBodySize <= 0 || r.bodySizePolicy != BodySizeTruncate {
+		return
+	}
+
+	data := reflect.ValueOf(response.Data)
+	if data.Kind() != reflect.Slice || r.fitsMaxBodySize(response.transferObject) {
+		return
+	}
+
+	ctx := getResponseContext(response)
+	response.transferObject.SetMeta(r.buildMeta(ctx, mergeMeta(response.Meta, map[string]interface{}{truncatedMetaKey: true}), response.MetaReplace))
+
+	// Find the largest number of items that fit
+	low, high := -1, data.Len()
+	for high-low > 1 {
+		middle := (low + high) / 2
+
+		response.transferObject.SetData(data.Slice(0, middle).Interface())
+		if r.fitsMaxBodySize(response.transferObject) {
+			low = middle
+			continue
+		}
+
+		high = middle
+	}
+
+	if low < 0 {
+		response.transferObject.SetMeta(r.buildMeta(ctx, response.Meta, response.MetaReplace))
+		response.transferObject.SetData(response.Data)
+		return
+	}
+
+	response.transferObject.SetData(data.Slice(0, low).Interface())
+}
+
+// fitsMaxBodySize returns whether the transfer object's encoded body fits the
+// replier's maximum body size
+func (r *Replier) fitsMaxBodySize(transferObject TransferObject) bool {
+	body := getBuffer()
+	defer putBuffer(body)
+
+	if err := r.encoder.Encode(body, getTransferObjectResponseBody(transferObject)); err != nil {
+		return false
+	}
+
+	return !r.isBodyOversized(body)
+}
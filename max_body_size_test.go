@@ -0,0 +1,91 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithMaxBodySize(t *testing.T) {
+
+	items := []string{"item-1", "item-2", "item-3", "item-4", "item-5", "item-6"}
+
+	tests := []struct {
+		name               string
+		maxBodySize        int
+		policy             reply.BodySizePolicy
+		data               interface{}
+		expectedErr        bool
+		expectedStatusCode int
+		expectedBody       string
+		expectedFlushed    bool
+	}{
+		{
+			name:               "Success - Body within maximum size",
+			maxBodySize:        65,
+			policy:             reply.BodySizeError,
+			data:               items,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":["item-1","item-2","item-3","item-4","item-5","item-6"]}`,
+		},
+		{
+			name:               "Failure - Oversized body replaced with error",
+			maxBodySize:        64,
+			policy:             reply.BodySizeError,
+			data:               items,
+			expectedErr:        true,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+		{
+			name:               "Success - Oversized data truncated",
+			maxBodySize:        64,
+			policy:             reply.BodySizeTruncate,
+			data:               items,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":["item-1","item-2","item-3"],"meta":{"truncated":true}}`,
+		},
+		{
+			name:               "Failure - Oversized data that can not be truncated",
+			maxBodySize:        16,
+			policy:             reply.BodySizeTruncate,
+			data:               getTestUser(),
+			expectedErr:        true,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+		{
+			name:               "Success - Oversized body chunked",
+			maxBodySize:        16,
+			policy:             reply.BodySizeChunk,
+			data:               items,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":["item-1","item-2","item-3","item-4","item-5","item-6"]}`,
+			expectedFlushed:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithMaxBodySize(test.maxBodySize, test.policy))
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, test.data)
+
+			var bodyTooLargeErr *reply.BodyTooLargeError
+			assert.Equal(t, test.expectedErr, errors.As(err, &bodyTooLargeErr))
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.expectedFlushed, w.Flushed)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+)
+
+// MergePolicy describes how manifests holding the same key are merged
+type MergePolicy int
+
+const (
+	// MergeLastWins keeps the item of the last manifest holding the key
+	MergeLastWins MergePolicy = iota
+
+	// MergeFirstWins keeps the item of the first manifest holding the key
+	MergeFirstWins
+
+	// MergeStrict keeps the item of the first manifest holding the key, and
+	// surfaces every conflict
+	MergeStrict
+)
+
+// ManifestConflict holds the colliding items of a manifest key
+type ManifestConflict struct {
+	Key         string
+	Existing    ErrorManifestItem
+	Conflicting ErrorManifestItem
+}
+
+// ManifestConflictError is returned when manifests merged using MergeStrict
+// hold different items for the same key
+type ManifestConflictError struct {
+	Conflicts []ManifestConflict
+}
+
+// Error returns the conflicting keys
+func (e *ManifestConflictError) Error() string {
+	keys := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		keys = append(keys, conflict.Key)
+	}
+
+	return fmt.Sprintf("reply/manifest: found %d conflicting manifest keys: %s", len(e.Conflicts), strings.Join(keys, ", "))
+}
+
+// WithMergePolicy sets how the manifests passed to NewReplier are merged when
+// they hold the same key. Conflicts found using MergeStrict are logged with
+// both colliding items.
+//
+// NOTE - Defaults to MergeLastWins. Identical items are never conflicts
+func WithMergePolicy(policy MergePolicy) Option {
+	return func(r *Replier) {
+		r.mergePolicy = policy
+	}
+}
+
+// MergeManifests merges the manifests into a single manifest using the policy.
+// When using MergeStrict, a ManifestConflictError is returned alongside the
+// merged manifest if any manifests hold different items for the same key.
+func MergeManifests(policy MergePolicy, manifests ...ErrorManifest) (ErrorManifest, error) {
+
+	mergedManifest := make(ErrorManifest)
+	var conflicts []ManifestConflict
+
+	for _, manifest := range manifests {
+		for _, key := range getSortedManifestKeys(manifest) {
+			item := manifest[key]
+
+			existing, ok := mergedManifest[key]
+			if !ok || reflect.DeepEqual(existing, item) {
+				mergedManifest[key] = item
+				continue
+			}
+
+			if policy == MergeStrict {
+				conflicts = append(conflicts, ManifestConflict{Key: key, Existing: existing, Conflicting: item})
+			}
+
+			if policy == MergeLastWins {
+				mergedManifest[key] = item
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return mergedManifest, &ManifestConflictError{Conflicts: conflicts}
+	}
+
+	return mergedManifest, nil
+}
+
+// mergeErrorManifests merges the manifests using the replier's merge policy,
+// logging any conflicts
+func (r *Replier) mergeErrorManifests(manifests []ErrorManifest) ErrorManifest {
+
+	mergedManifest, err := MergeManifests(r.mergePolicy, manifests...)
+	if conflictErr, ok := err.(*ManifestConflictError); ok {
+		for _, conflict := range conflictErr.Conflicts {
+			log.Printf("reply/manifest: conflicting items for key %q, keeping %+v over %+v", conflict.Key, conflict.Existing, conflict.Conflicting)
+		}
+	}
+
+	return mergedManifest
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeManifests(t *testing.T) {
+
+	first := reply.ErrorManifest{
+		"example-404-error": reply.ErrorManifestItem{Title: "Not Found", StatusCode: http.StatusNotFound},
+		"example-400-error": reply.ErrorManifestItem{Title: "Bad Request", StatusCode: http.StatusBadRequest},
+	}
+	second := reply.ErrorManifest{
+		"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", StatusCode: http.StatusNotFound},
+		"example-400-error": reply.ErrorManifestItem{Title: "Bad Request", StatusCode: http.StatusBadRequest},
+	}
+
+	tests := []struct {
+		name              string
+		policy            reply.MergePolicy
+		expectedTitle     string
+		expectedConflicts []reply.ManifestConflict
+	}{
+		{
+			name:          "Success - Last wins",
+			policy:        reply.MergeLastWins,
+			expectedTitle: "Resource Not Found",
+		},
+		{
+			name:          "Success - First wins",
+			policy:        reply.MergeFirstWins,
+			expectedTitle: "Not Found",
+		},
+		{
+			name:          "Failure - Strict returns conflicts",
+			policy:        reply.MergeStrict,
+			expectedTitle: "Not Found",
+			expectedConflicts: []reply.ManifestConflict{
+				{
+					Key:         "example-404-error",
+					Existing:    first["example-404-error"],
+					Conflicting: second["example-404-error"],
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := reply.MergeManifests(test.policy, first, second)
+
+			assert.Len(t, manifest, 2)
+			assert.Equal(t, test.expectedTitle, manifest["example-404-error"].Title)
+
+			if test.expectedConflicts == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var conflictErr *reply.ManifestConflictError
+			assert.True(t, errors.As(err, &conflictErr))
+			assert.Equal(t, test.expectedConflicts, conflictErr.Conflicts)
+			assert.Equal(t, "reply/manifest: found 1 conflicting manifest keys: example-404-error", err.Error())
+		})
+	}
+}
+
+func TestReplier_WithMergePolicy(t *testing.T) {
+
+	first := reply.ErrorManifest{"example-404-error": reply.ErrorManifestItem{Title: "Not Found", StatusCode: http.StatusNotFound}}
+	second := reply.ErrorManifest{"example-404-error": reply.ErrorManifestItem{Title: "Gone", StatusCode: http.StatusGone}}
+
+	tests := []struct {
+		name           string
+		options        []reply.Option
+		expectedStatus int
+	}{
+		{
+			name:           "Success - Defaults to last wins",
+			expectedStatus: http.StatusGone,
+		},
+		{
+			name:           "Success - First wins",
+			options:        []reply.Option{reply.WithMergePolicy(reply.MergeFirstWins)},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Success - Strict keeps first item",
+			options:        []reply.Option{reply.WithMergePolicy(reply.MergeStrict)},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{first, second}, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatus, w.Code)
+		})
+	}
+}
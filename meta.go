@@ -0,0 +1,189 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// MetaProvider returns a key and value that should be added to the
+// meta of every response. Providers are evaluated each time a response
+// is generated, with the response request's context.
+type MetaProvider func(ctx context.Context) (key string, value interface{})
+
+// WithDefaultMeta sets the meta providers used to add standard fields to
+// the meta of every response.
+//
+// NOTE - Meta passed with the response request is deep merged over values
+// from meta providers, taking precedence where keys are shared
+func WithDefaultMeta(providers ...MetaProvider) Option {
+	return func(r *Replier) {
+		r.defaultMetaProviders = append(r.defaultMetaProviders, providers...)
+	}
+}
+
+// MetaTimestamp returns a meta provider that adds the time the response was
+// generated (UTC, RFC 3339) under the `timestamp` key
+func MetaTimestamp() MetaProvider {
+	return func(ctx context.Context) (string, interface{}) {
+		return "timestamp", time.Now().UTC().Format(time.RFC3339)
+	}
+}
+
+// MetaHostname returns a meta provider that adds the host's name under the
+// `hostname` key.
+//
+// NOTE - The hostname is resolved once, when the provider is created
+func MetaHostname() MetaProvider {
+	hostname, _ := os.Hostname()
+
+	return func(ctx context.Context) (string, interface{}) {
+		return "hostname", hostname
+	}
+}
+
+// MetaRequestID returns a meta provider that adds a randomly generated
+// identifier under the `request_id` key
+func MetaRequestID() MetaProvider {
+	return func(ctx context.Context) (string, interface{}) {
+		return "request_id", generateRandomID()
+	}
+}
+
+// MetaStatic returns a meta provider that always adds the passed value
+// under the passed key, e.g. service name or version
+func MetaStatic(key string, value interface{}) MetaProvider {
+	return func(ctx context.Context) (string, interface{}) {
+		return key, value
+	}
+}
+
+// buildMeta returns the meta for the response. Values from the replier's
+// meta providers are added first, then the passed meta is deep merged over
+// them, or shallowly if replace is set.
+//
+// NOTE - If no meta providers are set, the passed meta is returned as is
+func (r *Replier) buildMeta(ctx context.Context, meta map[string]interface{}, replace bool) map[string]interface{} {
+
+	if len(r.defaultMetaProviders) == 0 {
+		return meta
+	}
+
+	builtMeta := make(map[string]interface{}, len(r.defaultMetaProviders)+len(meta))
+
+	for _, provider := range r.defaultMetaProviders {
+		key, value := provider(ctx)
+		if isEmpty(key) {
+			continue
+		}
+		builtMeta[key] = value
+	}
+
+	if !replace {
+		return mergeMeta(builtMeta, meta)
+	}
+
+	for key, value := range meta {
+		builtMeta[key] = value
+	}
+
+	return builtMeta
+}
+
+// mergeMeta returns a new map holding the passed meta deep merged over the
+// base meta. Nested maps present in both are merged, otherwise values from
+// the passed meta take precedence.
+//
+// NOTE - Neither the base nor the passed meta are modified
+func mergeMeta(base, meta map[string]interface{}) map[string]interface{} {
+
+	if len(base) == 0 && len(meta) == 0 {
+		return meta
+	}
+
+	mergedMeta := make(map[string]interface{}, len(base)+len(meta))
+
+	for key, value := range base {
+		mergedMeta[key] = value
+	}
+
+	for key, value := range meta {
+		baseValue, baseIsMap := mergedMeta[key].(map[string]interface{})
+		metaValue, metaIsMap := value.(map[string]interface{})
+		if baseIsMap && metaIsMap {
+			value = mergeMeta(baseValue, metaValue)
+		}
+
+		mergedMeta[key] = value
+	}
+
+	return mergedMeta
+}
+
+// TransferObjectMeta outlines the optional method a transfer object can
+// implement to hold meta passed as a struct with WithMeta, as is
+type TransferObjectMeta interface {
+	SetTypedMeta(meta interface{})
+}
+
+// mergeTypedMeta encodes the response's typed meta and merges it beneath the
+// response's meta, when the transfer object does not implement
+// TransferObjectMeta
+func (r *Replier) mergeTypedMeta(response *NewResponseRequest) error {
+
+	if response.TypedMeta == nil {
+		return nil
+	}
+
+	if _, ok := response.transferObject.(TransferObjectMeta); ok {
+		return nil
+	}
+
+	var encodedMeta bytes.Buffer
+	if err := r.encoder.Encode(&encodedMeta, response.TypedMeta); err != nil {
+		return fmt.Errorf("reply/typed-meta: failed to encode meta, %w", err)
+	}
+
+	var meta map[string]interface{}
+	decoder := json.NewDecoder(&encodedMeta)
+	decoder.UseNumber()
+	if err := decoder.Decode(&meta); err != nil {
+		return fmt.Errorf("reply/typed-meta: meta of type %T must encode to a JSON object, %w", response.TypedMeta, err)
+	}
+
+	response.Meta = mergeMeta(meta, response.Meta)
+
+	return nil
+}
+
+// setTransferObjectTypedMeta hands the response's typed meta to transfer
+// objects implementing TransferObjectMeta
+func (r *Replier) setTransferObjectTypedMeta(response *NewResponseRequest) {
+
+	if response.TypedMeta == nil {
+		return
+	}
+
+	if metaTransferObject, ok := response.transferObject.(TransferObjectMeta); ok {
+		metaTransferObject.SetTypedMeta(response.TypedMeta)
+	}
+}
+
+// generateRandomID returns a random 16 byte hex encoded identifier
+func generateRandomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
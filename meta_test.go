@@ -0,0 +1,294 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithDefaultMeta(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		providers      []reply.MetaProvider
+		request        reply.NewResponseRequest
+		assertResponse func(w *httptest.ResponseRecorder, t *testing.T)
+	}{
+		{
+			name:      "Success - Default meta added to blank response",
+			providers: []reply.MetaProvider{reply.MetaStatic("service", "users")},
+			request:   reply.NewResponseRequest{},
+			assertResponse: func(w *httptest.ResponseRecorder, t *testing.T) {
+				assert.Equal(t, stringWithNewLine(`{"data":"{}","meta":{"service":"users"}}`), w.Body.String())
+			},
+		},
+		{
+			name:      "Success - Request meta takes precedence over default meta",
+			providers: []reply.MetaProvider{reply.MetaStatic("example", "default"), reply.MetaStatic("service", "users")},
+			request: reply.NewResponseRequest{
+				Data: getTestUser(),
+				Meta: getReplyFormattedMeta(),
+			},
+			assertResponse: func(w *httptest.ResponseRecorder, t *testing.T) {
+				assert.Equal(t, stringWithNewLine(`{"data":{"id":"some-id","name":"john doe"},"meta":{"example":"meta in response","service":"users"}}`), w.Body.String())
+			},
+		},
+		{
+			name:      "Success - Provider values evaluated per response",
+			providers: []reply.MetaProvider{reply.MetaTimestamp(), reply.MetaHostname(), reply.MetaRequestID()},
+			request:   reply.NewResponseRequest{},
+			assertResponse: func(w *httptest.ResponseRecorder, t *testing.T) {
+				var body struct {
+					Meta map[string]interface{} `json:"meta"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Contains(t, body.Meta, "timestamp")
+				assert.Contains(t, body.Meta, "hostname")
+				assert.Len(t, body.Meta["request_id"], 32)
+			},
+		},
+		{
+			name:    "Success - No providers leaves meta omitted",
+			request: reply.NewResponseRequest{},
+			assertResponse: func(w *httptest.ResponseRecorder, t *testing.T) {
+				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), w.Body.String())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithDefaultMeta(test.providers...))
+
+			test.request.Writer = w
+			err := replier.NewHTTPResponse(&test.request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, w.Code)
+			test.assertResponse(w, t)
+		})
+	}
+}
+
+func TestReplier_WithMetaMerge(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		providers    []reply.MetaProvider
+		attributes   []reply.ResponseAttributes
+		expectedBody string
+	}{
+		{
+			name: "Success - Repeated calls deep merged",
+			attributes: []reply.ResponseAttributes{
+				reply.WithMeta(map[string]interface{}{"page": map[string]interface{}{"size": 10}, "region": "eu"}),
+				reply.WithMeta(map[string]interface{}{"page": map[string]interface{}{"number": 2}, "region": "us"}),
+			},
+			expectedBody: `{"data":"{}","meta":{"page":{"number":2,"size":10},"region":"us"}}`,
+		},
+		{
+			name:      "Success - Response meta deep merged over default meta",
+			providers: []reply.MetaProvider{reply.MetaStatic("service", map[string]interface{}{"name": "users", "version": "1.0"})},
+			attributes: []reply.ResponseAttributes{
+				reply.WithMeta(map[string]interface{}{"service": map[string]interface{}{"version": "1.1"}}),
+			},
+			expectedBody: `{"data":"{}","meta":{"service":{"name":"users","version":"1.1"}}}`,
+		},
+		{
+			name:      "Success - Replace restores overwrite semantics",
+			providers: []reply.MetaProvider{reply.MetaStatic("service", map[string]interface{}{"name": "users", "version": "1.0"})},
+			attributes: []reply.ResponseAttributes{
+				reply.WithMetaReplace(),
+				reply.WithMeta(map[string]interface{}{"region": "eu"}),
+				reply.WithMeta(map[string]interface{}{"service": map[string]interface{}{"version": "1.1"}}),
+			},
+			expectedBody: `{"data":"{}","meta":{"service":{"version":"1.1"}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{}, reply.WithDefaultMeta(test.providers...))
+
+			err := replier.NewHTTPBlankResponse(w, http.StatusOK, test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestWithMeta_DoesNotModifyPassedMeta(t *testing.T) {
+
+	first := map[string]interface{}{"page": map[string]interface{}{"size": 10}}
+	request := reply.NewResponseRequest{}
+
+	reply.WithMeta(first)(&request)
+	reply.WithMeta(map[string]interface{}{"page": map[string]interface{}{"number": 2}})(&request)
+
+	assert.Equal(t, map[string]interface{}{"page": map[string]interface{}{"size": 10}}, first)
+	assert.Equal(t, map[string]interface{}{"page": map[string]interface{}{"size": 10, "number": 2}}, request.Meta)
+}
+
+func TestReplier_WithMetaEntry(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		providers    []reply.MetaProvider
+		attributes   []reply.ResponseAttributes
+		expectedBody string
+	}{
+		{
+			name:         "Success - Single entry",
+			attributes:   []reply.ResponseAttributes{reply.WithMetaEntry("elapsed_ms", 12)},
+			expectedBody: `{"data":"{}","meta":{"elapsed_ms":12}}`,
+		},
+		{
+			name:      "Success - Composes with meta and default meta",
+			providers: []reply.MetaProvider{reply.MetaStatic("service", "users")},
+			attributes: []reply.ResponseAttributes{
+				reply.WithMeta(map[string]interface{}{"region": "eu", "elapsed_ms": 1}),
+				reply.WithMetaEntry("elapsed_ms", 12),
+				reply.WithMetaEntry("service", "accounts"),
+			},
+			expectedBody: `{"data":"{}","meta":{"elapsed_ms":12,"region":"eu","service":"accounts"}}`,
+		},
+		{
+			name: "Success - Later meta overrides entry",
+			attributes: []reply.ResponseAttributes{
+				reply.WithMetaEntry("region", "us"),
+				reply.WithMeta(map[string]interface{}{"region": "eu"}),
+			},
+			expectedBody: `{"data":"{}","meta":{"region":"eu"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{}, reply.WithDefaultMeta(test.providers...))
+
+			err := replier.NewHTTPBlankResponse(w, http.StatusOK, test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+// pageMeta is an example of a well-defined meta schema
+type pageMeta struct {
+	Page  int `json:"page"`
+	Total int `json:"total"`
+}
+
+// pageMetaTransferObject is a transfer object holding its meta as pageMeta
+type pageMetaTransferObject struct {
+	reply.TypedTransferObject[user]
+	Meta *pageMeta `json:"meta,omitempty"`
+}
+
+// SetTypedMeta adds meta to the transfer object, if it is a pageMeta
+func (t *pageMetaTransferObject) SetTypedMeta(meta interface{}) {
+	t.Meta, _ = meta.(*pageMeta)
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+func (t *pageMetaTransferObject) RefreshTransferObject() reply.TransferObject {
+	return &pageMetaTransferObject{}
+}
+
+func TestReplier_WithTypedMeta(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		attributes         []reply.ResponseAttributes
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Typed meta merged beneath map meta",
+			options:            []reply.Option{reply.WithDefaultMeta(reply.MetaStatic("service", "users"))},
+			attributes:         []reply.ResponseAttributes{reply.WithMeta(pageMeta{Page: 2, Total: 40}), reply.WithMeta(map[string]interface{}{"total": 41})},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"meta":{"page":2,"service":"users","total":41}}`,
+		},
+		{
+			name:               "Success - Typed meta set as is on transfer object",
+			options:            []reply.Option{reply.WithTransferObject(&pageMetaTransferObject{})},
+			attributes:         []reply.ResponseAttributes{reply.WithMeta(&pageMeta{Page: 2, Total: 40})},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"meta":{"page":2,"total":40}}`,
+		},
+		{
+			name:               "Success - Nil meta ignored",
+			attributes:         []reply.ResponseAttributes{reply.WithMeta(nil)},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       getDataResponseBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+
+	t.Run("Failure - Typed meta not encoded to object", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getDefaultErrorManifest())
+
+		err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithMeta([]string{"page"}))
+
+		assert.EqualError(t, err, "reply/typed-meta: meta of type []string must encode to a JSON object, json: cannot unmarshal array into Go value of type map[string]interface {}")
+	})
+
+	for name, attribute := range map[string]reply.ResponseAttributes{
+		"head":      reply.WithMethod(http.MethodHead),
+		"signature": reply.WithSignature(reply.NewHMACSigner([]byte("secret"))),
+	} {
+		t.Run("Failure - Typed meta error leaves "+name+" response unwritten", func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), attribute, reply.WithMeta([]string{"page"}))
+			assert.Error(t, err)
+			assert.Empty(t, w.Body.String())
+
+			assert.NoError(t, replier.NewHTTPErrorResponse(w, err, attribute))
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+		})
+	}
+
+	t.Run("Success - Typed meta replaced by later typed meta", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		replier := reply.NewReplier(getDefaultErrorManifest())
+
+		err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+			reply.WithMeta(pageMeta{Page: 1, Total: 40}),
+			reply.WithMeta(map[string]interface{}{"service": "users"}),
+			reply.WithMeta(pageMeta{Page: 2}),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, stringWithNewLine(`{"data":{"id":"some-id","name":"john doe"},"meta":{"page":2,"service":"users","total":0}}`), w.Body.String())
+	})
+}
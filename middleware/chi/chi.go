@@ -0,0 +1,103 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replychi integrates reply with chi routers. Its middleware makes a
+// replier available to every handler through reply.FromContext, while its
+// not found and method not allowed handlers respond using the replier's
+// error manifest.
+package replychi
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ooaklee/reply"
+)
+
+// methods holds the methods checked when listing a route's allowed methods
+var methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
+// Register installs the middleware, not found and method not allowed handlers
+// on the router.
+//
+// NOTE - chi requires middlewares to be installed before any routes, call
+// Register before defining the router's routes
+func Register(router chi.Router, replier *reply.Replier) {
+	router.Use(Middleware(replier))
+	router.NotFound(NotFoundHandler(replier))
+	router.MethodNotAllowed(MethodNotAllowedHandler(replier))
+}
+
+// Middleware installs a clone of the replier in every request's context, retrieved
+// using reply.FromContext
+func Middleware(replier *reply.Replier) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(reply.NewContext(r.Context(), replier.Clone())))
+		})
+	}
+}
+
+// NotFoundHandler returns a handler responding with the replier's not found
+// handler, see reply.Replier.NotFoundHandler
+func NotFoundHandler(replier *reply.Replier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getRequestReplier(replier, r).NotFoundHandler().ServeHTTP(w, r)
+	}
+}
+
+// MethodNotAllowedHandler returns a handler responding with the replier's method
+// not allowed handler, with the `Allow` header listing the methods the route
+// supports, see reply.Replier.MethodNotAllowedHandler
+func MethodNotAllowedHandler(replier *reply.Replier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getRequestReplier(replier, r).MethodNotAllowedHandler(getAllowedMethods(r)...).ServeHTTP(w, r)
+	}
+}
+
+// getRequestReplier returns the replier installed by the middleware, falling back
+// to a clone of the passed replier
+func getRequestReplier(replier *reply.Replier, r *http.Request) *reply.Replier {
+	if requestReplier := reply.FromContext(r); requestReplier != nil {
+		return requestReplier
+	}
+
+	return replier.Clone()
+}
+
+// getAllowedMethods returns the methods the request's route supports
+func getAllowedMethods(r *http.Request) []string {
+
+	routeContext := chi.RouteContext(r.Context())
+	if routeContext == nil || routeContext.Routes == nil {
+		return nil
+	}
+
+	path := routeContext.RoutePath
+	if path == "" {
+		path = r.URL.RawPath
+	}
+	if path == "" {
+		path = r.URL.Path
+	}
+
+	var allowed []string
+	for _, method := range methods {
+		if routeContext.Routes.Match(chi.NewRouteContext(), method, path) {
+			allowed = append(allowed, method)
+		}
+	}
+
+	return allowed
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replychi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/ooaklee/reply"
+	replychi "github.com/ooaklee/reply/middleware/chi"
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestRouter returns a router with reply registered and a single users route
+func getTestRouter(manifests []reply.ErrorManifest) chi.Router {
+	router := chi.NewRouter()
+	replychi.Register(router, reply.NewReplier(manifests))
+
+	router.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_ = reply.FromContext(r).NewHTTPDataResponse(w, http.StatusOK, map[string]string{"id": chi.URLParam(r, "id")})
+	})
+	router.Delete("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_ = reply.FromContext(r).NewHTTPBlankResponse(w, http.StatusNoContent)
+	})
+
+	return router
+}
+
+func TestRegister(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		manifests     []reply.ErrorManifest
+		method        string
+		path          string
+		expectedCode  int
+		expectedBody  string
+		expectedAllow string
+	}{
+		{
+			name:         "Success - Replier available from context",
+			method:       http.MethodGet,
+			path:         "/users/some-id",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"id":"some-id"}}`,
+		},
+		{
+			name:         "Success - Unmatched route",
+			method:       http.MethodGet,
+			path:         "/accounts",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Not Found","status":"404"}]}`,
+		},
+		{
+			name: "Success - Unmatched route using manifest",
+			manifests: []reply.ErrorManifest{
+				{"not-found": reply.ErrorManifestItem{Title: "Route Not Found", Code: "R404", StatusCode: http.StatusNotFound}},
+			},
+			method:       http.MethodGet,
+			path:         "/accounts",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Route Not Found","status":"404","code":"R404"}]}`,
+		},
+		{
+			name:          "Success - Unsupported method",
+			method:        http.MethodPost,
+			path:          "/users/some-id",
+			expectedCode:  http.StatusMethodNotAllowed,
+			expectedBody:  `{"errors":[{"title":"Method Not Allowed","status":"405"}]}`,
+			expectedAllow: "GET, DELETE",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			getTestRouter(test.manifests).ServeHTTP(w, httptest.NewRequest(test.method, test.path, nil))
+
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, test.expectedBody+"\n", w.Body.String())
+			assert.Equal(t, test.expectedAllow, w.Header().Get("Allow"))
+		})
+	}
+}
+
+func TestMiddleware_ClonesReplierPerRequest(t *testing.T) {
+
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+	var first, second *reply.Replier
+
+	router := chi.NewRouter()
+	router.Use(replychi.Middleware(replier))
+	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		if first == nil {
+			first = reply.FromContext(r)
+			return
+		}
+		second = reply.FromContext(r)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.NotNil(t, first)
+	assert.NotNil(t, second)
+	assert.NotSame(t, replier, first)
+	assert.NotSame(t, first, second)
+}
+
+func TestFromContext_Missing(t *testing.T) {
+	assert.Nil(t, reply.FromContext(httptest.NewRequest(http.MethodGet, "/", nil)))
+}
@@ -0,0 +1,17 @@
+module github.com/ooaklee/reply/middleware/chi
+
+go 1.19
+
+require (
+	github.com/go-chi/chi/v5 v5.0.12
+	github.com/ooaklee/reply v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/ooaklee/reply => ../..
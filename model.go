@@ -46,10 +46,31 @@ type ErrorManifestItem struct {
 	// Meta contains additional meta-information about the that can be shared to
 	// consumer
 	Meta interface{}
+
+	// Translations holds the title and detail translated for each supported
+	// language tag, i.e. `fr`, `en-GB`
+	Translations map[string]ErrorManifestItemTranslation
+
+	// Namespace holds the namespace the item was contributed under, see
+	// NamespacedManifest. It is not returned to the consumer.
+	Namespace string
+
+	// Severity controls whether the error response is logged, at what level,
+	// and whether it is passed to the error reporter. If unset, only errors
+	// without a manifest entry are logged, and 5xx or unmatched errors reported
+	Severity Severity
+
+	// statusCodeDefaulted records whether the status code was set by the
+	// replier, as the item did not set its own
+	statusCodeDefaulted bool
 }
 
 // ErrorManifest holds error reference (string) with its corresponding
 // manifest item (message & status code) which it returned in the response
+//
+// NOTE - Keys ending with `*`, i.e. `validation/*`, match every error starting
+// with the rest of the key. Exact matches always take precedence, followed by
+// the longest matching wildcard key.
 type ErrorManifest map[string]ErrorManifestItem
 
 // defaultReplyTransferObjectError holds attributes often used to give additional
@@ -73,6 +94,27 @@ type defaultReplyTransferObjectError struct {
 
 	// Meta contains additional meta-information about the error
 	Meta interface{} `json:"meta,omitempty"`
+
+	// Source holds references to the part of the request that caused the error
+	Source *defaultReplyTransferObjectErrorSource `json:"source,omitempty"`
+
+	// Fields holds the message of each field that caused the error
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// Count the number of times the error occurred, when repeats are collapsed
+	Count int `json:"count,omitempty"`
+}
+
+// defaultReplyTransferObjectErrorSource holds references to the part of the
+// request that caused the error
+type defaultReplyTransferObjectErrorSource struct {
+
+	// Pointer a JSON Pointer [RFC6901] to the value in the request body that
+	// caused the error, i.e. `/data/name`
+	Pointer string `json:"pointer,omitempty"`
+
+	// Parameter the query parameter that caused the error
+	Parameter string `json:"parameter,omitempty"`
 }
 
 // SetTitle adds title to error
@@ -135,6 +177,26 @@ func (e *defaultReplyTransferObjectError) GetMeta() interface{} {
 	return e.Meta
 }
 
+// SetSource adds the pointer and/ or parameter that caused the error
+func (e *defaultReplyTransferObjectError) SetSource(pointer, parameter string) {
+	if isEmpty(pointer) && isEmpty(parameter) {
+		e.Source = nil
+		return
+	}
+
+	e.Source = &defaultReplyTransferObjectErrorSource{Pointer: pointer, Parameter: parameter}
+}
+
+// SetFields adds the message of each field that caused the error
+func (e *defaultReplyTransferObjectError) SetFields(fields map[string]string) {
+	e.Fields = fields
+}
+
+// SetCount adds the number of times the error occurred
+func (e *defaultReplyTransferObjectError) SetCount(count int) {
+	e.Count = count
+}
+
 // RefreshTransferObject returns an empty instance of transfer object
 // error
 func (e *defaultReplyTransferObjectError) RefreshTransferObject() TransferObjectError {
@@ -149,8 +211,10 @@ type defaultReplyTransferObject struct {
 	StatusCode int                    `json:"-"`
 	Errors     []TransferObjectError  `json:"errors,omitempty"`
 	Data       interface{}            `json:"data,omitempty"`
+	Warnings   []TransferObjectError  `json:"warnings,omitempty"`
 	TokenOne   string                 `json:"access_token,omitempty"`
 	TokenTwo   string                 `json:"refresh_token,omitempty"`
+	Tokens     map[string]string      `json:"-"`
 	Meta       map[string]interface{} `json:"meta,omitempty"`
 }
 
@@ -206,7 +270,17 @@ func (t *defaultReplyTransferObject) RefreshTransferObject() TransferObject {
 	return &defaultReplyTransferObject{}
 }
 
+// Reset clears the transfer object so it can be reused for the next response
+func (t *defaultReplyTransferObject) Reset() {
+	*t = defaultReplyTransferObject{}
+}
+
 // SetErrors assigns the passed transfer object errors to the transfer object
 func (t *defaultReplyTransferObject) SetErrors(transferObjectErrors []TransferObjectError) {
 	t.Errors = transferObjectErrors
 }
+
+// SetWarnings assigns the passed warnings to the transfer object
+func (t *defaultReplyTransferObject) SetWarnings(warnings []TransferObjectError) {
+	t.Warnings = warnings
+}
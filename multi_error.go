@@ -0,0 +1,288 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// ErrorSortOrder is used to define how errors in a multi error response
+// are ordered
+type ErrorSortOrder int
+
+const (
+	// ErrorSortNone keeps errors in the order they were passed
+	ErrorSortNone ErrorSortOrder = iota
+
+	// ErrorSortByCode orders errors by their code, then status
+	ErrorSortByCode
+
+	// ErrorSortByStatus orders errors by their status, then code
+	ErrorSortByStatus
+)
+
+// UnmatchedErrorPolicy describes how errors without a manifest entry are handled
+// in multi error responses
+type UnmatchedErrorPolicy int
+
+const (
+	// UnmatchedErrorsCollapse returns only the fallback error when any error
+	// does not have a manifest entry, as the fallback item is a 5XX
+	UnmatchedErrorsCollapse UnmatchedErrorPolicy = iota
+
+	// UnmatchedErrorsDrop removes errors without a manifest entry, unless no
+	// errors are left, in which case only the fallback error is returned
+	UnmatchedErrorsDrop
+
+	// UnmatchedErrorsInclude returns errors without a manifest entry, using the
+	// fallback item, alongside the matched errors
+	UnmatchedErrorsInclude
+)
+
+// MultiErrorStatusStrategy returns the status code of a multi error response from
+// the status codes of its errors, in the order they are returned. A strategy
+// should return `0` to use the replier's default error status code.
+type MultiErrorStatusStrategy func(statusCodes []int) int
+
+// TransferObjectErrorCount outlines the optional method a transfer object error
+// can implement to show how many times it was repeated in the response
+type TransferObjectErrorCount interface {
+	SetCount(count int)
+}
+
+// WithErrorDeduplication removes identical errors from multi error responses,
+// keeping the first occurrence. If countRepeats is true, the number of times
+// the error occurred is added to its transfer object error, if it implements
+// TransferObjectErrorCount.
+func WithErrorDeduplication(countRepeats bool) Option {
+	return func(r *Replier) {
+		r.deduplicateErrors = true
+		r.countRepeatedErrors = countRepeats
+	}
+}
+
+// WithUnmatchedErrorPolicy sets how errors without a manifest entry are handled
+// in multi error responses, so one unknown error does not have to hide the
+// others
+//
+// NOTE - Defaults to UnmatchedErrorsCollapse. Errors with a 5XX manifest entry
+// always collapse the response to that error
+func WithUnmatchedErrorPolicy(policy UnmatchedErrorPolicy) Option {
+	return func(r *Replier) {
+		r.unmatchedErrorPolicy = policy
+	}
+}
+
+// WithErrorSortOrder sets how errors in multi error responses are ordered.
+// Sorting is stable, errors considered equal keep the order they were passed.
+//
+// NOTE - The response's status code is resolved after errors are ordered
+func WithErrorSortOrder(order ErrorSortOrder) Option {
+	return func(r *Replier) {
+		r.errorSortOrder = order
+	}
+}
+
+// organiseTransferObjectErrors deduplicates and orders the transfer object errors
+// based on the replier's configuration
+func (r *Replier) organiseTransferObjectErrors(transferObjectErrors []TransferObjectError) []TransferObjectError {
+
+	if r.deduplicateErrors {
+		transferObjectErrors = r.deduplicateTransferObjectErrors(transferObjectErrors)
+	}
+
+	sortTransferObjectErrors(transferObjectErrors, r.errorSortOrder)
+
+	return transferObjectErrors
+}
+
+// deduplicateTransferObjectErrors returns the transfer object errors with identical
+// errors removed, keeping the first occurrence
+func (r *Replier) deduplicateTransferObjectErrors(transferObjectErrors []TransferObjectError) []TransferObjectError {
+
+	deduplicated := []TransferObjectError{}
+	counts := []int{}
+
+	for _, transferObjectError := range transferObjectErrors {
+		duplicate := false
+
+		for i, existing := range deduplicated {
+			if reflect.DeepEqual(existing, transferObjectError) {
+				counts[i]++
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			deduplicated = append(deduplicated, transferObjectError)
+			counts = append(counts, 1)
+		}
+	}
+
+	if r.countRepeatedErrors {
+		for i, transferObjectError := range deduplicated {
+			if countTransferObjectError, ok := transferObjectError.(TransferObjectErrorCount); ok {
+				countTransferObjectError.SetCount(counts[i])
+			}
+		}
+	}
+
+	return deduplicated
+}
+
+// sortTransferObjectErrors stable sorts the transfer object errors in the
+// passed order
+func sortTransferObjectErrors(transferObjectErrors []TransferObjectError, order ErrorSortOrder) {
+
+	switch order {
+	case ErrorSortByCode:
+		sort.SliceStable(transferObjectErrors, func(i, j int) bool {
+			a, b := transferObjectErrors[i], transferObjectErrors[j]
+			if a.GetCode() != b.GetCode() {
+				return a.GetCode() < b.GetCode()
+			}
+			return compareStatusCodes(a.GetStatusCode(), b.GetStatusCode())
+		})
+	case ErrorSortByStatus:
+		sort.SliceStable(transferObjectErrors, func(i, j int) bool {
+			a, b := transferObjectErrors[i], transferObjectErrors[j]
+			if a.GetStatusCode() != b.GetStatusCode() {
+				return compareStatusCodes(a.GetStatusCode(), b.GetStatusCode())
+			}
+			return a.GetCode() < b.GetCode()
+		})
+	}
+}
+
+// compareStatusCodes returns whether status code a should be ordered before
+// status code b. Status codes that can't be converted are ordered last.
+func compareStatusCodes(a, b string) bool {
+	aStatusCode, aErr := strconv.Atoi(a)
+	bStatusCode, bErr := strconv.Atoi(b)
+
+	switch {
+	case aErr != nil:
+		return false
+	case bErr != nil:
+		return true
+	}
+
+	return aStatusCode < bStatusCode
+}
+
+// WithMultiErrorStatusStrategy sets the strategy used to resolve the status code
+// of multi error responses, i.e. MultiErrorStatusHighest
+//
+// NOTE - Defaults to MultiErrorStatusFirst. The strategy is not used when a
+// response is collapsed to a 5XX error
+func WithMultiErrorStatusStrategy(strategy MultiErrorStatusStrategy) Option {
+	return func(r *Replier) {
+		r.multiErrorStatusStrategy = strategy
+	}
+}
+
+// MultiErrorStatusFirst is a multi error status strategy using the status code
+// of the first error
+func MultiErrorStatusFirst(statusCodes []int) int {
+	if len(statusCodes) == 0 {
+		return 0
+	}
+
+	return statusCodes[0]
+}
+
+// MultiErrorStatusHighest is a multi error status strategy using the highest
+// status code of the errors
+func MultiErrorStatusHighest(statusCodes []int) int {
+	highest := 0
+	for _, statusCode := range statusCodes {
+		if statusCode > highest {
+			highest = statusCode
+		}
+	}
+
+	return highest
+}
+
+// MultiErrorStatusMostFrequent is a multi error status strategy using the status
+// code shared by most errors. Ties are won by the status code returned first.
+func MultiErrorStatusMostFrequent(statusCodes []int) int {
+	counts := make(map[int]int, len(statusCodes))
+	for _, statusCode := range statusCodes {
+		counts[statusCode]++
+	}
+
+	mostFrequent := 0
+	for _, statusCode := range statusCodes {
+		if counts[statusCode] > counts[mostFrequent] {
+			mostFrequent = statusCode
+		}
+	}
+
+	return mostFrequent
+}
+
+// MultiErrorStatusForMixed4xx returns a multi error status strategy using the
+// errors' status code when they share one, the passed status code (i.e. `400`)
+// when they hold different 4XX status codes, and the highest status code otherwise
+func MultiErrorStatusForMixed4xx(statusCode int) MultiErrorStatusStrategy {
+	return func(statusCodes []int) int {
+		if len(statusCodes) == 0 {
+			return 0
+		}
+
+		mixed, all4xx := false, true
+		for _, errorStatusCode := range statusCodes {
+			mixed = mixed || errorStatusCode != statusCodes[0]
+			all4xx = all4xx && errorStatusCode >= 400 && errorStatusCode <= 499
+		}
+
+		switch {
+		case !mixed:
+			return statusCodes[0]
+		case all4xx:
+			return statusCode
+		}
+
+		return MultiErrorStatusHighest(statusCodes)
+	}
+}
+
+// getMultiErrorStatusCode returns the status code of the multi error response
+// resolved using the replier's strategy from the errors' status codes. Status
+// codes that can't be converted are ignored.
+//
+// NOTE - If the strategy returns `0` or an invalid status code, the replier's
+// default error status code is used
+func (r *Replier) getMultiErrorStatusCode(transferObjectErrors []TransferObjectError) int {
+
+	statusCodes := make([]int, 0, len(transferObjectErrors))
+	for _, transferObjectError := range transferObjectErrors {
+		if statusCode, err := strconv.Atoi(transferObjectError.GetStatusCode()); err == nil {
+			statusCodes = append(statusCodes, statusCode)
+		}
+	}
+
+	strategy := r.multiErrorStatusStrategy
+	if strategy == nil {
+		strategy = MultiErrorStatusFirst
+	}
+
+	statusCode := strategy(statusCodes)
+	if statusCode == 0 {
+		return r.defaultErrorStatusCode
+	}
+
+	if !isValidStatusCode(statusCode) {
+		log.Printf("reply/http-response: multi error status strategy returned invalid status code %d, using %d", statusCode, r.defaultErrorStatusCode)
+		return r.defaultErrorStatusCode
+	}
+
+	return statusCode
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_MultiErrorDeduplicationAndOrdering(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-conflict-error": reply.ErrorManifestItem{Title: "Conflict", StatusCode: http.StatusConflict, Code: "0001"},
+	})
+
+	errs := []error{
+		errors.New("example-name-validation-error"),
+		errors.New("example-conflict-error"),
+		errors.New("example-name-validation-error"),
+		errors.New("example-dob-validation-error"),
+	}
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Errors kept as passed by default",
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011"},{"title":"Conflict","status":"409","code":"0001"},{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011"},{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Identical errors removed",
+			options:            []reply.Option{reply.WithErrorDeduplication(false)},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011"},{"title":"Conflict","status":"409","code":"0001"},{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Identical errors collapsed with count",
+			options:            []reply.Option{reply.WithErrorDeduplication(true)},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011","count":2},{"title":"Conflict","status":"409","code":"0001","count":1},{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT","count":1}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Errors ordered by code",
+			options:            []reply.Option{reply.WithErrorDeduplication(false), reply.WithErrorSortOrder(reply.ErrorSortByCode)},
+			expectedBody:       `{"errors":[{"title":"Conflict","status":"409","code":"0001"},{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"},{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011"}]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Success - Errors ordered by status",
+			options:            []reply.Option{reply.WithErrorDeduplication(false), reply.WithErrorSortOrder(reply.ErrorSortByStatus)},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"},{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011"},{"title":"Conflict","status":"409","code":"0001"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPMultiErrorResponse(w, errs)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_MultiErrorUnmatchedErrorPolicy(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		errs               []error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Unmatched error collapses response by default",
+			errs:               []error{errors.New("example-dob-validation-error"), errors.New("example-unknown-error")},
+			expectedBody:       getErrorResponseISEBody(),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:               "Success - Unmatched error dropped",
+			options:            []reply.Option{reply.WithUnmatchedErrorPolicy(reply.UnmatchedErrorsDrop)},
+			errs:               []error{errors.New("example-unknown-error"), errors.New("example-dob-validation-error")},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Fallback error returned when every error dropped",
+			options:            []reply.Option{reply.WithUnmatchedErrorPolicy(reply.UnmatchedErrorsDrop)},
+			errs:               []error{errors.New("example-unknown-error"), errors.New("example-other-unknown-error")},
+			expectedBody:       getErrorResponseISEBody(),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:               "Success - Unmatched error included alongside matched errors",
+			options:            []reply.Option{reply.WithUnmatchedErrorPolicy(reply.UnmatchedErrorsInclude)},
+			errs:               []error{errors.New("example-dob-validation-error"), errors.New("example-unknown-error")},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"},{"title":"Internal Server Error","status":"500"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "Success - Matched 5XX error collapses response regardless of policy",
+			options: []reply.Option{reply.WithUnmatchedErrorPolicy(reply.UnmatchedErrorsInclude)},
+			errs: []error{
+				errors.New("example-dob-validation-error"),
+				reply.NewErrorWithDefaultItem("example-unavailable-error", reply.ErrorManifestItem{Title: "Service Unavailable", StatusCode: http.StatusServiceUnavailable}),
+			},
+			expectedBody:       `{"errors":[{"title":"Service Unavailable","status":"503"}]}`,
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := replier.NewHTTPMultiErrorResponse(w, test.errs)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_MultiErrorStatusStrategy(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-conflict-error":      reply.ErrorManifestItem{Title: "Conflict", StatusCode: http.StatusConflict},
+		"example-unprocessable-error": reply.ErrorManifestItem{Title: "Unprocessable", StatusCode: http.StatusUnprocessableEntity},
+	})
+
+	tests := []struct {
+		name               string
+		strategy           reply.MultiErrorStatusStrategy
+		errs               []string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - First status wins by default",
+			errs:               []string{"example-dob-validation-error", "example-conflict-error", "example-conflict-error"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Highest status wins",
+			strategy:           reply.MultiErrorStatusHighest,
+			errs:               []string{"example-dob-validation-error", "example-unprocessable-error", "example-conflict-error"},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Success - Most frequent status wins",
+			strategy:           reply.MultiErrorStatusMostFrequent,
+			errs:               []string{"example-dob-validation-error", "example-conflict-error", "example-conflict-error"},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Success - Most frequent status tie won by first status",
+			strategy:           reply.MultiErrorStatusMostFrequent,
+			errs:               []string{"example-dob-validation-error", "example-conflict-error", "example-conflict-error", "example-name-validation-error"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Mixed 4XX statuses use passed status",
+			strategy:           reply.MultiErrorStatusForMixed4xx(http.StatusBadRequest),
+			errs:               []string{"example-conflict-error", "example-unprocessable-error"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Shared 4XX status used",
+			strategy:           reply.MultiErrorStatusForMixed4xx(http.StatusBadRequest),
+			errs:               []string{"example-conflict-error", "example-conflict-error"},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Success - Invalid strategy status uses default",
+			strategy:           func(statusCodes []int) int { return 1000 },
+			errs:               []string{"example-conflict-error"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, reply.WithMultiErrorStatusStrategy(test.strategy))
+
+			errs := []error{}
+			for _, err := range test.errs {
+				errs = append(errs, errors.New(err))
+			}
+
+			err := replier.NewHTTPMultiErrorResponse(w, errs)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestMultiErrorStatusForMixed4xx(t *testing.T) {
+
+	strategy := reply.MultiErrorStatusForMixed4xx(http.StatusBadRequest)
+
+	assert.Equal(t, 0, strategy(nil))
+	assert.Equal(t, http.StatusBadGateway, strategy([]int{http.StatusConflict, http.StatusBadGateway}))
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// MultiStatusResult holds the outcome of a single item in a bulk operation
+type MultiStatusResult struct {
+
+	// ID the identifier of the item, if known
+	ID string
+
+	// Data the item's success payload
+	Data interface{}
+
+	// Error the error returned when processing the item. When set, the error
+	// is resolved using the error manifest and data is ignored.
+	Error error
+
+	// StatusCode the status code of a successful item, defaults to `200`
+	StatusCode int
+}
+
+// multiStatusEntry represents a single item's result in a multi-status
+// response
+type multiStatusEntry struct {
+	Index  int                   `json:"index"`
+	ID     string                `json:"id,omitempty"`
+	Status string                `json:"status"`
+	Data   interface{}           `json:"data,omitempty"`
+	Errors []TransferObjectError `json:"errors,omitempty"`
+}
+
+// NewHTTPMultiStatusResponse this response aide is used to create
+// a multi-status (207) response for bulk operations. Each result is
+// returned as an entry, keyed by its index (and ID if set), holding either
+// its data or its manifest resolved error.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+func (r *Replier) NewHTTPMultiStatusResponse(w http.ResponseWriter, results []MultiStatusResult, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: http.StatusMultiStatus,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	request.Data = r.buildMultiStatusEntries(results, &request)
+
+	return r.NewHTTPResponse(&request)
+}
+
+// buildMultiStatusEntries converts the results into multi-status entries,
+// resolving the manifest item of any errors
+func (r *Replier) buildMultiStatusEntries(results []MultiStatusResult, response *NewResponseRequest) []multiStatusEntry {
+
+	entries := make([]multiStatusEntry, 0, len(results))
+
+	for i, result := range results {
+		entry := multiStatusEntry{Index: i, ID: result.ID}
+
+		if result.Error != nil {
+			manifestItem, _ := r.resolveSentErrorManifestItem(result.Error, response)
+
+			entry.Status = strconv.Itoa(manifestItem.StatusCode)
+			entry.Errors = []TransferObjectError{r.buildTransferObjectError(result.Error, manifestItem, response)}
+			entries = append(entries, entry)
+
+			continue
+		}
+
+		statusCode := result.StatusCode
+		if statusCode == 0 {
+			statusCode = defaultStatusCode
+		}
+
+		entry.Status = strconv.Itoa(statusCode)
+		entry.Data = result.Data
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
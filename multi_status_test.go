@@ -0,0 +1,58 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPMultiStatusResponseAide(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		results            []reply.MultiStatusResult
+		responseAttributes []reply.ResponseAttributes
+		expectedBody       string
+	}{
+		{
+			name: "Success - Mixed success and error results",
+			results: []reply.MultiStatusResult{
+				{ID: "some-id", Data: getTestUser(), StatusCode: http.StatusCreated},
+				{ID: "other-id", Error: getExampleErrorOne()},
+				{Data: getTestUser()},
+			},
+			expectedBody: `{"data":[{"index":0,"id":"some-id","status":"201","data":{"id":"some-id","name":"john doe"}},{"index":1,"id":"other-id","status":"404","errors":[{"title":"Resource Not Found","status":"404"}]},{"index":2,"status":"200","data":{"id":"some-id","name":"john doe"}}]}`,
+		},
+		{
+			name: "Success - Unmatched error uses fallback without affecting other results",
+			results: []reply.MultiStatusResult{
+				{Error: errors.New("example-missing-error")},
+				{Data: getTestUser(), StatusCode: http.StatusCreated},
+			},
+			responseAttributes: []reply.ResponseAttributes{reply.WithMeta(getReplyFormattedMeta())},
+			expectedBody:       `{"data":[{"index":0,"status":"500","errors":[{"title":"Internal Server Error","status":"500"}]},{"index":1,"status":"201","data":{"id":"some-id","name":"john doe"}}],"meta":{"example":"meta in response"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			err := replier.NewHTTPMultiStatusResponse(w, test.results, test.responseAttributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+const (
+	// namespaceSeparator separates a manifest key's namespace from the key
+	namespaceSeparator = "/"
+)
+
+// NamespacedManifest returns a copy of the manifest with each key prefixed by the
+// namespace, i.e. `payments/card-declined`, so shared libraries can contribute
+// error sets without key collisions. Each item's Namespace is set, so the
+// namespace that produced an error is available to reporters and debug
+// information.
+//
+// NOTE - Errors must match the prefixed key, i.e. `errors.New("payments/card-declined")`
+func NamespacedManifest(namespace string, manifest ErrorManifest) ErrorManifest {
+
+	namespacedManifest := make(ErrorManifest, len(manifest))
+
+	for key, item := range manifest {
+		if isEmpty(item.Namespace) {
+			item.Namespace = namespace
+		} else {
+			item.Namespace = namespace + namespaceSeparator + item.Namespace
+		}
+
+		namespacedManifest[namespace+namespaceSeparator+key] = item
+	}
+
+	return namespacedManifest
+}
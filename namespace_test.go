@@ -0,0 +1,84 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespacedManifest(t *testing.T) {
+
+	manifest := reply.NamespacedManifest("payments", reply.ErrorManifest{
+		"card-declined": reply.ErrorManifestItem{Title: "Card Declined", StatusCode: http.StatusPaymentRequired},
+		"upstream":      reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway, Namespace: "stripe"},
+	})
+
+	assert.Equal(t, reply.ErrorManifest{
+		"payments/card-declined": reply.ErrorManifestItem{Title: "Card Declined", StatusCode: http.StatusPaymentRequired, Namespace: "payments"},
+		"payments/upstream":      reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway, Namespace: "payments/stripe"},
+	}, manifest)
+}
+
+func TestReplier_NamespacedManifest(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"card-declined": reply.ErrorManifestItem{Title: "Card Declined", StatusCode: http.StatusBadRequest}},
+		reply.NamespacedManifest("payments", reply.ErrorManifest{
+			"card-declined": reply.ErrorManifestItem{Title: "Card Declined", StatusCode: http.StatusPaymentRequired},
+			"upstream":      reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway},
+		}),
+	}
+
+	tests := []struct {
+		name              string
+		err               error
+		expectedBody      string
+		expectedNamespace string
+	}{
+		{
+			name:         "Success - Namespaced key does not collide",
+			err:          errors.New("card-declined"),
+			expectedBody: `{"errors":[{"title":"Card Declined","status":"400"}]}`,
+		},
+		{
+			name:              "Success - Namespaced key",
+			err:               errors.New("payments/card-declined"),
+			expectedBody:      `{"errors":[{"title":"Card Declined","status":"402"}]}`,
+			expectedNamespace: "payments",
+		},
+		{
+			name:              "Success - Namespace in debug information",
+			err:               errors.New("payments/upstream"),
+			expectedBody:      `{"errors":[{"title":"Bad Gateway","status":"502","meta":{"debug":{"error":"payments/upstream","namespace":"payments"}}}]}`,
+			expectedNamespace: "payments",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			var reports []reply.ErrorReport
+			replier := reply.NewReplier(manifests, reply.WithDebug(true), reply.WithErrorReporter(reply.ReporterFunc(func(report reply.ErrorReport) {
+				reports = append(reports, report)
+			})))
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, test.expectedNamespace, replier.ResolveErrorManifestItem(test.err).Namespace)
+			for _, report := range reports {
+				assert.Equal(t, test.expectedNamespace, report.ManifestItem.Namespace)
+			}
+		})
+	}
+}
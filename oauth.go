@@ -0,0 +1,50 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"net/http"
+)
+
+// OAuthToken holds a successful access token response, as described in
+// RFC 6749 section 5.1
+type OAuthToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// NewHTTPOAuthTokenResponse this response aide is used to create RFC 6749 compliant
+// access token responses. The token is written at the top level of the response
+// body, with the mandated `Cache-Control: no-store` and `Pragma: no-cache` headers.
+//
+// NOTE - Meta is not included in OAuth token responses
+func (r *Replier) NewHTTPOAuthTokenResponse(w http.ResponseWriter, token OAuthToken, attributes ...ResponseAttributes) error {
+
+	if isEmpty(token.AccessToken) || isEmpty(token.TokenType) {
+		return errors.New("reply/http-oauth-token-aide: failed access token and token type must be provided")
+	}
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: http.StatusOK,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	setResponseHeader(&request, "Cache-Control", "no-store")
+	setResponseHeader(&request, "Pragma", "no-cache")
+
+	request.Data = token
+	request.UnwrappedData = true
+
+	return r.NewHTTPResponse(&request)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPOAuthTokenResponse(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		token        reply.OAuthToken
+		attributes   []reply.ResponseAttributes
+		expectedErr  error
+		expectedBody string
+	}{
+		{
+			name:        "Failure - Missing token type",
+			token:       reply.OAuthToken{AccessToken: "access"},
+			expectedErr: errors.New("reply/http-oauth-token-aide: failed access token and token type must be provided"),
+		},
+		{
+			name:         "Success - Minimal token",
+			token:        reply.OAuthToken{AccessToken: "access", TokenType: "Bearer"},
+			expectedBody: `{"access_token":"access","token_type":"Bearer"}`,
+		},
+		{
+			name:         "Success - Full token ignores meta",
+			token:        reply.OAuthToken{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 3600, RefreshToken: "refresh", Scope: "read write"},
+			attributes:   []reply.ResponseAttributes{reply.WithMeta(map[string]interface{}{"example": "meta in response"})},
+			expectedBody: `{"access_token":"access","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh","scope":"read write"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPOAuthTokenResponse(w, test.token, test.attributes...)
+
+			if test.expectedErr != nil {
+				assert.Equal(t, test.expectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			assert.Equal(t, "no-cache", w.Header().Get("Pragma"))
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	// openAPIErrorSchemaName is the name of the schema generated for
+	// error responses
+	openAPIErrorSchemaName = "ErrorResponse"
+)
+
+// openAPIComponentNameDisallowed matches the characters not allowed in OpenAPI
+// component names
+var openAPIComponentNameDisallowed = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// OpenAPIComponents holds the OpenAPI 3.1 `components` generated from an
+// error manifest
+type OpenAPIComponents struct {
+	Responses map[string]OpenAPIResponse `json:"responses"`
+	Schemas   map[string]interface{}     `json:"schemas"`
+}
+
+// OpenAPIResponse represents an OpenAPI response object
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIMediaType represents an OpenAPI media type object
+type OpenAPIMediaType struct {
+	Schema  map[string]interface{} `json:"schema"`
+	Example interface{}            `json:"example,omitempty"`
+}
+
+// GenerateOpenAPIComponents returns the OpenAPI 3.1 response and schema components for
+// every item in the manifests. Each response is named after its manifest key, and
+// holds an example body rendered using the replier options passed (i.e. custom
+// transfer objects), so API documentation never drifts from the error catalogue.
+//
+// The `ErrorResponse` schema is inferred from the example bodies.
+func GenerateOpenAPIComponents(manifests []ErrorManifest, options ...Option) (*OpenAPIComponents, error) {
+
+	replier := NewReplier(manifests, options...)
+
+	components := OpenAPIComponents{
+		Responses: map[string]OpenAPIResponse{},
+		Schemas:   map[string]interface{}{},
+	}
+
+	var schema map[string]interface{}
+
+	for _, key := range getSortedManifestKeys(replier.errorManifest) {
+		item := replier.errorManifest[key]
+
+		w := newBufferedResponseWriter()
+		if err := replier.NewHTTPResponse(&NewResponseRequest{Writer: w, Error: &manifestKeyError{key: key, item: item}}); err != nil {
+			return nil, fmt.Errorf("reply/openapi: failed to render example for %q with %v", key, err)
+		}
+
+		var example interface{}
+		if err := json.Unmarshal(w.body.Bytes(), &example); err != nil {
+			return nil, fmt.Errorf("reply/openapi: failed to decode example for %q with %v", key, err)
+		}
+
+		schema = mergeOpenAPISchemas(schema, inferOpenAPISchema(example))
+
+		description := item.Title
+		if isEmpty(description) {
+			description = "Status " + strconv.Itoa(item.StatusCode)
+		}
+
+		components.Responses[getOpenAPIComponentName(key)] = OpenAPIResponse{
+			Description: description,
+			Content: map[string]OpenAPIMediaType{
+				w.Header().Get("Content-Type"): {
+					Schema:  map[string]interface{}{"$ref": "#/components/schemas/" + openAPIErrorSchemaName},
+					Example: example,
+				},
+			},
+		}
+	}
+
+	if schema != nil {
+		components.Schemas[openAPIErrorSchemaName] = schema
+	}
+
+	return &components, nil
+}
+
+// manifestKeyError is used to render a manifest item exactly as it is
+// held in the manifest, including wildcard keys
+type manifestKeyError struct {
+	key  string
+	item ErrorManifestItem
+}
+
+// Error returns the manifest key
+func (e *manifestKeyError) Error() string {
+	return e.key
+}
+
+// ErrorManifestItem returns the manifest item
+func (e *manifestKeyError) ErrorManifestItem() ErrorManifestItem {
+	return e.item
+}
+
+// getSortedManifestKeys returns the manifest's keys in alphabetical order
+func getSortedManifestKeys(manifest ErrorManifest) []string {
+	keys := make([]string, 0, len(manifest))
+	for key := range manifest {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// getOpenAPIComponentName returns the manifest key with characters not allowed
+// in component names replaced
+func getOpenAPIComponentName(key string) string {
+	return openAPIComponentNameDisallowed.ReplaceAllString(key, "_")
+}
+
+// inferOpenAPISchema returns the OpenAPI schema inferred from the decoded JSON value
+func inferOpenAPISchema(value interface{}) map[string]interface{} {
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		properties := map[string]interface{}{}
+		for key, propertyValue := range typedValue {
+			properties[key] = inferOpenAPISchema(propertyValue)
+		}
+		return map[string]interface{}{"type": "object", "properties": properties}
+	case []interface{}:
+		var items map[string]interface{}
+		for _, itemValue := range typedValue {
+			items = mergeOpenAPISchemas(items, inferOpenAPISchema(itemValue))
+		}
+		if items == nil {
+			items = map[string]interface{}{}
+		}
+		return map[string]interface{}{"type": "array", "items": items}
+	case string:
+		return map[string]interface{}{"type": "string"}
+	case float64:
+		return map[string]interface{}{"type": "number"}
+	case bool:
+		return map[string]interface{}{"type": "boolean"}
+	}
+
+	return map[string]interface{}{}
+}
+
+// mergeOpenAPISchemas returns the union of the passed schemas' object properties
+// and array items. If the schemas' types differ, the first schema is kept.
+func mergeOpenAPISchemas(a, b map[string]interface{}) map[string]interface{} {
+
+	if a == nil {
+		return b
+	}
+
+	if b == nil || a["type"] != b["type"] {
+		return a
+	}
+
+	switch a["type"] {
+	case "object":
+		properties := map[string]interface{}{}
+		for key, property := range a["properties"].(map[string]interface{}) {
+			properties[key] = property
+		}
+		for key, property := range b["properties"].(map[string]interface{}) {
+			existing, ok := properties[key].(map[string]interface{})
+			if !ok {
+				properties[key] = property
+				continue
+			}
+			properties[key] = mergeOpenAPISchemas(existing, property.(map[string]interface{}))
+		}
+		return map[string]interface{}{"type": "object", "properties": properties}
+	case "array":
+		return map[string]interface{}{"type": "array", "items": mergeOpenAPISchemas(a["items"].(map[string]interface{}), b["items"].(map[string]interface{}))}
+	}
+
+	return a
+}
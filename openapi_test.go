@@ -0,0 +1,54 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateOpenAPIComponents(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		options          []reply.Option
+		expectedResponse string
+		expectedSchema   string
+	}{
+		{
+			name:             "Success - Components generated with default transfer objects",
+			expectedResponse: `{"description":"Resource Not Found","content":{"application/json":{"schema":{"$ref":"#/components/schemas/ErrorResponse"},"example":{"errors":[{"status":"404","title":"Resource Not Found"}]}}}}`,
+			expectedSchema:   `{"properties":{"errors":{"items":{"properties":{"about":{"type":"string"},"code":{"type":"string"},"detail":{"type":"string"},"status":{"type":"string"},"title":{"type":"string"}},"type":"object"},"type":"array"}},"type":"object"}`,
+		},
+		{
+			name:             "Success - Components generated with custom transfer objects",
+			options:          []reply.Option{reply.WithTransferObject(&fooReplyTransferObject{}), reply.WithTransferObjectError(&barError{})},
+			expectedResponse: `{"description":"Resource Not Found","content":{"application/json":{"schema":{"$ref":"#/components/schemas/ErrorResponse"},"example":{"bar":{"errors":[{"more":{"status":"404"},"title":"Resource Not Found"}]}}}}}`,
+			expectedSchema:   `{"properties":{"bar":{"properties":{"errors":{"items":{"properties":{"about":{"type":"string"},"message":{"type":"string"},"more":{"properties":{"code":{"type":"string"},"status":{"type":"string"}},"type":"object"},"title":{"type":"string"}},"type":"object"},"type":"array"}},"type":"object"}},"type":"object"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			components, err := reply.GenerateOpenAPIComponents(getDefaultErrorManifest(), test.options...)
+			assert.NoError(t, err)
+
+			assert.Len(t, components.Responses, 3)
+			assert.Contains(t, components.Responses, "example-name-validation-error")
+
+			response, err := json.Marshal(components.Responses["example-404-error"])
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, string(response))
+
+			schema, err := json.Marshal(components.Schemas["ErrorResponse"])
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedSchema, string(schema))
+		})
+	}
+}
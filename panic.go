@@ -0,0 +1,63 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+)
+
+// PanicError is the error passed to the replier's reporter for a panic value
+// passed to NewHTTPResponseFromPanic
+type PanicError struct {
+
+	// Value the recovered panic value
+	Value interface{}
+
+	// Stack the stack trace of the goroutine that recovered the panic
+	Stack []byte
+}
+
+// Error returns the panic value
+//
+// NOTE - PanicError does not unwrap to an error panic value, so the panic is
+// never resolved using the value's manifest entry
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("reply/panic: %v", e.Value)
+}
+
+// NewHTTPResponseFromPanic this response aide is used to create the fallback
+// (500) error response for a recovered panic value, so it can be used from an
+// existing recovery middleware, i.e.
+//
+//	defer func() {
+//		if recovered := recover(); recovered != nil {
+//			_ = replier.NewHTTPResponseFromPanic(w, recovered)
+//		}
+//	}()
+//
+// The panic value, whether an error, string or any other value, is passed to the
+// replier's reporter as a PanicError, holding the value and the stack trace.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - `http.ErrAbortHandler` panics are meant to abort the response, and
+// should be re-panicked rather than passed to this aide
+func (r *Replier) NewHTTPResponseFromPanic(w http.ResponseWriter, recovered interface{}, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Error:  &PanicError{Value: recovered, Stack: debug.Stack()},
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPResponseFromPanic(t *testing.T) {
+
+	tests := []struct {
+		name                 string
+		recovered            interface{}
+		expectedReportedText string
+	}{
+		{
+			name:                 "Success - Error panic value",
+			recovered:            errors.New("example-404-error"),
+			expectedReportedText: "reply/panic: example-404-error",
+		},
+		{
+			name:                 "Success - String panic value",
+			recovered:            "index out of range",
+			expectedReportedText: "reply/panic: index out of range",
+		},
+		{
+			name:                 "Success - Other panic value",
+			recovered:            42,
+			expectedReportedText: "reply/panic: 42",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reports []reply.ErrorReport
+
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithErrorReporter(reply.ReporterFunc(func(report reply.ErrorReport) {
+				reports = append(reports, report)
+			})))
+
+			func() {
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						assert.NoError(t, replier.NewHTTPResponseFromPanic(w, recovered))
+					}
+				}()
+
+				panic(test.recovered)
+			}()
+
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+			assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), w.Body.String())
+
+			if assert.Len(t, reports, 1) {
+				var panicErr *reply.PanicError
+				assert.True(t, errors.As(reports[0].Error, &panicErr))
+				assert.Equal(t, test.recovered, panicErr.Value)
+				assert.Contains(t, string(panicErr.Stack), "TestReplier_NewHTTPResponseFromPanic")
+				assert.Equal(t, test.expectedReportedText, reports[0].Error.Error())
+				assert.False(t, reports[0].Matched)
+			}
+		})
+	}
+}
+
+func TestReplier_NewHTTPResponseFromPanicWithFallbackOverride(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	err := replier.NewHTTPResponseFromPanic(w, "nil map", reply.WithFallbackErrorItem(reply.ErrorManifestItem{Title: "Something Went Wrong", StatusCode: http.StatusServiceUnavailable}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, stringWithNewLine(`{"errors":[{"title":"Something Went Wrong","status":"503"}]}`), w.Body.String())
+}
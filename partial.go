@@ -0,0 +1,65 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// NewHTTPPartialResponse this response aide is used to create a partially
+// successful response, whose envelope holds both the passed data and errors,
+// i.e. a bulk import where most rows succeed and some fail:
+//
+//	{"data":[...],"errors":[...]}
+//
+// The status code is used as passed (i.e. `200` or `207`), regardless of the
+// errors' status codes, and every error is kept, with errors without a manifest
+// entry using the fallback item.
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - The data is always wrapped in the envelope, see WithUnwrappedData
+func (r *Replier) NewHTTPPartialResponse(w http.ResponseWriter, statusCode int, data interface{}, errs []error, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: statusCode,
+		Data:       data,
+		Errors:     errs,
+		Partial:    true,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// generatePartialResponse generates a response holding both the response's data
+// and errors
+func (r *Replier) generatePartialResponse(response *NewResponseRequest) error {
+
+	transferObjectErrors := make([]TransferObjectError, 0, len(response.Errors))
+	for _, err := range response.Errors {
+		manifestItem, _ := r.resolveSentErrorManifestItem(err, response)
+		transferObjectErrors = append(transferObjectErrors, r.buildTransferObjectError(err, manifestItem, response))
+	}
+
+	if len(transferObjectErrors) > 0 {
+		transferObjectErrors = r.organiseTransferObjectErrors(transferObjectErrors)
+
+		response.transferObject.SetErrors(transferObjectErrors)
+		response.result.setErrorCodes(transferObjectErrors)
+	}
+
+	response.transferObject.SetData(response.Data)
+	r.truncateTransferObjectData(response)
+	r.setTransferObjectWarnings(response)
+
+	return r.sendHTTPResponse(response)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPPartialResponse(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		statusCode         int
+		data               interface{}
+		errs               []error
+		attributes         []reply.ResponseAttributes
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Data and errors sent together",
+			statusCode:         http.StatusMultiStatus,
+			data:               []user{getTestUser()},
+			errs:               []error{reply.ErrorWithSource(errors.New("example-dob-validation-error"), "/data/1/dob", "")},
+			expectedStatusCode: http.StatusMultiStatus,
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT","source":{"pointer":"/data/1/dob"}}],"data":[{"id":"some-id","name":"john doe"}]}`,
+		},
+		{
+			name:               "Success - Unmatched error kept with fallback item",
+			statusCode:         http.StatusOK,
+			data:               []user{getTestUser()},
+			errs:               []error{errors.New("example-404-error"), errors.New("example-unknown-error")},
+			attributes:         []reply.ResponseAttributes{reply.WithMeta(map[string]interface{}{"failed": 2})},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","status":"404"},{"title":"Internal Server Error","status":"500"}],"data":[{"id":"some-id","name":"john doe"}],"meta":{"failed":2}}`,
+		},
+		{
+			name:               "Success - Data sent without errors",
+			statusCode:         http.StatusOK,
+			data:               getTestUser(),
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       getDataResponseBody(),
+		},
+		{
+			name:               "Success - Envelope kept with unwrapped data",
+			options:            []reply.Option{reply.WithUnwrappedData()},
+			statusCode:         http.StatusOK,
+			data:               getTestUser(),
+			errs:               []error{errors.New("example-404-error")},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","status":"404"}],"data":{"id":"some-id","name":"john doe"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := replier.NewHTTPPartialResponse(w, test.statusCode, test.data, test.errs, test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// ResponseKind describes the kind of response generated from the attributes of
+// a response request
+type ResponseKind int
+
+const (
+	// ResponseKindErrors is a multi error response, generated when the response
+	// request holds errors
+	ResponseKindErrors ResponseKind = iota
+
+	// ResponseKindError is an error response, generated when the response
+	// request holds an error
+	ResponseKindError
+
+	// ResponseKindTokens is a token response, generated when the response
+	// request holds tokens
+	ResponseKindTokens
+
+	// ResponseKindStream is a streamed events response, generated when the
+	// response request holds a stream
+	ResponseKindStream
+
+	// ResponseKindDataStream is a streamed data response, generated when the
+	// response request holds a data stream
+	ResponseKindDataStream
+
+	// ResponseKindData is a data response, generated when the response request
+	// holds data
+	ResponseKindData
+)
+
+// defaultResponsePrecedence is the order in which the kinds of response are
+// attempted when a response request holds more than one
+var defaultResponsePrecedence = []ResponseKind{
+	ResponseKindErrors,
+	ResponseKindError,
+	ResponseKindTokens,
+	ResponseKindStream,
+	ResponseKindDataStream,
+	ResponseKindData,
+}
+
+// WithResponsePrecedence sets which kind of response is generated when a response
+// request holds more than one, i.e. preferring data over non-fatal errors for
+// APIs returning partial results:
+//
+//	reply.WithResponsePrecedence(reply.ResponseKindData)
+//
+// The passed kinds take precedence in the order passed, followed by the kinds
+// not passed in their default order.
+//
+// NOTE - Defaults to errors, error, tokens, stream, data stream then data.
+// Response requests holding none of them generate the default (blank) response.
+func WithResponsePrecedence(kinds ...ResponseKind) Option {
+	return func(r *Replier) {
+		precedence := make([]ResponseKind, 0, len(defaultResponsePrecedence))
+		seen := make(map[ResponseKind]bool, len(defaultResponsePrecedence))
+
+		for _, kind := range append(append([]ResponseKind{}, kinds...), defaultResponsePrecedence...) {
+			if seen[kind] || kind < ResponseKindErrors || kind > ResponseKindData {
+				continue
+			}
+
+			seen[kind] = true
+			precedence = append(precedence, kind)
+		}
+
+		r.responsePrecedence = precedence
+	}
+}
+
+// getResponseKind returns the kind of response generated for the response
+// request, using the replier's precedence, and whether it holds any
+func (r *Replier) getResponseKind(response *NewResponseRequest) (ResponseKind, bool) {
+
+	precedence := r.responsePrecedence
+	if len(precedence) == 0 {
+		precedence = defaultResponsePrecedence
+	}
+
+	for _, kind := range precedence {
+		if hasResponseKind(response, kind) {
+			return kind, true
+		}
+	}
+
+	return ResponseKindData, false
+}
+
+// isErrorResponse returns whether an error response is generated for the
+// response request
+func (r *Replier) isErrorResponse(response *NewResponseRequest) bool {
+	kind, ok := r.getResponseKind(response)
+
+	return ok && (kind == ResponseKindErrors || kind == ResponseKindError)
+}
+
+// hasResponseKind returns whether the response request holds the attributes of
+// the kind of response
+func hasResponseKind(response *NewResponseRequest, kind ResponseKind) bool {
+	switch kind {
+	case ResponseKindErrors:
+		return len(response.Errors) > 0
+	case ResponseKindError:
+		return response.Error != nil
+	case ResponseKindTokens:
+		return hasTokens(response)
+	case ResponseKindStream:
+		return response.Stream != nil
+	case ResponseKindDataStream:
+		return response.DataStream != nil
+	case ResponseKindData:
+		return response.Data != nil
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithResponsePrecedence(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		request            reply.NewResponseRequest
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Success - Errors take precedence over data by default",
+			request: reply.NewResponseRequest{
+				StatusCode: http.StatusOK,
+				Data:       getTestUser(),
+				Errors:     []error{errors.New("example-dob-validation-error")},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT"}]}`,
+		},
+		{
+			name:    "Success - Data takes precedence over errors",
+			options: []reply.Option{reply.WithResponsePrecedence(reply.ResponseKindData)},
+			request: reply.NewResponseRequest{
+				StatusCode: http.StatusOK,
+				Data:       getTestUser(),
+				Errors:     []error{errors.New("example-dob-validation-error")},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       getDataResponseBody(),
+		},
+		{
+			name:    "Success - Kinds not passed keep default order",
+			options: []reply.Option{reply.WithResponsePrecedence(reply.ResponseKindData)},
+			request: reply.NewResponseRequest{
+				StatusCode: http.StatusOK,
+				TokenOne:   "access-token",
+				Error:      errors.New("example-404-error"),
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","status":"404"}]}`,
+		},
+		{
+			name:    "Success - Tokens take precedence over error",
+			options: []reply.Option{reply.WithResponsePrecedence(reply.ResponseKindTokens, reply.ResponseKindError)},
+			request: reply.NewResponseRequest{
+				StatusCode: http.StatusOK,
+				TokenOne:   "access-token",
+				Error:      errors.New("example-404-error"),
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"access_token":"access-token"}`,
+		},
+		{
+			name:    "Success - Default response without any kind",
+			options: []reply.Option{reply.WithResponsePrecedence(reply.ResponseKindData)},
+			request: reply.NewResponseRequest{
+				StatusCode: http.StatusOK,
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":"{}"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			request := test.request
+			request.Writer = w
+
+			err := replier.NewHTTPResponse(&request)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"strings"
+)
+
+// profileContextKey is the context key holding the name of the manifest profile
+// used for responses, see ContextWithProfile
+type profileContextKey struct{}
+
+// manifestProfile holds a named set of manifests, see WithManifestProfile
+type manifestProfile struct {
+	manifests        []ErrorManifest
+	errorManifest    ErrorManifest
+	wildcardPrefixes []string
+}
+
+// WithManifestProfile registers a named set of manifests (i.e. `v1`, `partner`)
+// on the replier, for APIs whose error contracts differ across versions or
+// tenants. The profile used is chosen per response, see WithProfile and
+// ContextWithProfile.
+//
+// NOTE - Items in the profile take precedence over the replier's manifest,
+// errors without an entry in the profile use the replier's manifest
+func WithManifestProfile(name string, manifests ...ErrorManifest) Option {
+	return func(r *Replier) {
+		if r.manifestProfiles == nil {
+			r.manifestProfiles = make(map[string]*manifestProfile)
+		}
+
+		profile, ok := r.manifestProfiles[name]
+		if !ok {
+			profile = &manifestProfile{}
+			r.manifestProfiles[name] = profile
+		}
+
+		profile.manifests = append(profile.manifests, manifests...)
+	}
+}
+
+// WithProfile sets the manifest profile used to resolve the response's error(s),
+// taking precedence over a profile held in the response's context
+func WithProfile(name string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Profile = name
+	}
+}
+
+// ContextWithProfile returns a copy of the context holding the name of the
+// manifest profile used for responses generated with it, i.e. set by a
+// middleware from the request's API version or tenant
+func ContextWithProfile(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, profileContextKey{}, name)
+}
+
+// buildManifestProfiles merges the manifests of each profile using the replier's
+// merge policy, resolving the status codes of their items
+func (r *Replier) buildManifestProfiles() {
+	for _, profile := range r.manifestProfiles {
+		profile.errorManifest = r.mergeErrorManifests(profile.manifests)
+
+		for key, item := range profile.errorManifest {
+			r.resolveManifestItemStatusCode(key, &item)
+			profile.errorManifest[key] = item
+		}
+
+		profile.wildcardPrefixes = getWildcardPrefixes(profile.errorManifest)
+	}
+}
+
+// getManifestProfile returns the manifest profile chosen for the response, and
+// whether one is registered
+func (r *Replier) getManifestProfile(response *NewResponseRequest) (*manifestProfile, bool) {
+	if len(r.manifestProfiles) == 0 || response == nil {
+		return nil, false
+	}
+
+	name := response.Profile
+	if isEmpty(name) {
+		name, _ = getResponseContext(response).Value(profileContextKey{}).(string)
+	}
+
+	profile, ok := r.manifestProfiles[name]
+
+	return profile, ok
+}
+
+// lookupResponseErrorManifestItem returns the manifest item that corresponds with
+// the passed key in the response's manifest profile, or the replier's manifest,
+// and whether one was found
+func (r *Replier) lookupResponseErrorManifestItem(key string, response *NewResponseRequest) (ErrorManifestItem, bool) {
+	if profile, ok := r.getManifestProfile(response); ok {
+		if manifestItem, ok := profile.lookup(key); ok {
+			return manifestItem, true
+		}
+	}
+
+	return r.lookupErrorManifestItem(key)
+}
+
+// lookup returns the profile's manifest item that corresponds with the passed
+// key, using the replier's exact and wildcard key precedence rules
+func (p *manifestProfile) lookup(key string) (ErrorManifestItem, bool) {
+	if manifestItem, ok := p.errorManifest[key]; ok {
+		return manifestItem, true
+	}
+
+	for _, prefix := range p.wildcardPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return p.errorManifest[prefix+manifestWildcardSuffix], true
+		}
+	}
+
+	return ErrorManifestItem{}, false
+}
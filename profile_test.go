@@ -0,0 +1,91 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithManifestProfile(t *testing.T) {
+
+	errUserNotFound := errors.New("user not found")
+	errQuotaExceeded := errors.New("quota/exceeded")
+
+	replier := reply.NewReplier([]reply.ErrorManifest{
+		{
+			errUserNotFound.Error():  reply.ErrorManifestItem{Title: "User Not Found", StatusCode: http.StatusNotFound},
+			errQuotaExceeded.Error(): reply.ErrorManifestItem{Title: "Quota Exceeded", StatusCode: http.StatusTooManyRequests},
+		},
+	},
+		reply.WithManifestProfile("v1", reply.ErrorManifest{
+			errUserNotFound.Error(): reply.ErrorManifestItem{Title: "Not Found", Code: "V1-404", StatusCode: http.StatusNotFound},
+		}),
+		reply.WithManifestProfile("partner", reply.ErrorManifest{
+			"quota/*": reply.ErrorManifestItem{Title: "Partner Quota Exceeded"},
+		}),
+	)
+
+	tests := []struct {
+		name               string
+		err                error
+		attributes         []reply.ResponseAttributes
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Replier manifest without profile",
+			err:                errUserNotFound,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"User Not Found","status":"404"}]}`,
+		},
+		{
+			name:               "Success - Profile chosen by attribute",
+			err:                errUserNotFound,
+			attributes:         []reply.ResponseAttributes{reply.WithProfile("v1")},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"Not Found","status":"404","code":"V1-404"}]}`,
+		},
+		{
+			name:               "Success - Profile chosen by context",
+			err:                errQuotaExceeded,
+			attributes:         []reply.ResponseAttributes{reply.WithContext(reply.ContextWithProfile(context.Background(), "partner"))},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"errors":[{"title":"Partner Quota Exceeded","status":"400"}]}`,
+		},
+		{
+			name:               "Success - Replier manifest used for errors missing from profile",
+			err:                errQuotaExceeded,
+			attributes:         []reply.ResponseAttributes{reply.WithProfile("v1")},
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedBody:       `{"errors":[{"title":"Quota Exceeded","status":"429"}]}`,
+		},
+		{
+			name:               "Success - Unknown profile uses replier manifest",
+			err:                errUserNotFound,
+			attributes:         []reply.ResponseAttributes{reply.WithProfile("v9")},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"User Not Found","status":"404"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			err := replier.NewHTTPErrorResponse(w, test.err, test.attributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
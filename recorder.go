@@ -0,0 +1,146 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"time"
+)
+
+// PostSendHook is called once the replier has sent a response, with the
+// response request and the result of the response, i.e. to write access logs
+type PostSendHook func(response *NewResponseRequest, result ResponseResult)
+
+// WithPostSendHooks sets the hooks called, in the order passed, after each
+// response is sent by the replier
+//
+// NOTE - Hooks are called even if the response failed to send, the result
+// reflects what was written to the client
+func WithPostSendHooks(hooks ...PostSendHook) Option {
+	return func(r *Replier) {
+		r.postSendHooks = append(r.postSendHooks, hooks...)
+	}
+}
+
+// ResponseWriterRecorder wraps a http.ResponseWriter, recording the status code,
+// number of bytes written and when the response was first written. Wrapping a
+// handler's writer with it allows middlewares to log every response, and to
+// detect handlers that respond without the replier, see Replied.
+type ResponseWriterRecorder struct {
+	http.ResponseWriter
+	statusCode   int
+	bytesWritten int
+	firstWriteAt time.Time
+	replied      bool
+}
+
+// NewResponseWriterRecorder returns a recorder wrapping the writer
+func NewResponseWriterRecorder(w http.ResponseWriter) *ResponseWriterRecorder {
+	return &ResponseWriterRecorder{ResponseWriter: w}
+}
+
+// GetResponseWriterRecorder returns the first recorder found in the writer's
+// chain of wrapped writers, and whether one was found
+func GetResponseWriterRecorder(w http.ResponseWriter) (*ResponseWriterRecorder, bool) {
+	for w != nil {
+		if recorder, ok := w.(*ResponseWriterRecorder); ok {
+			return recorder, true
+		}
+
+		unwrapper, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			break
+		}
+		w = unwrapper.Unwrap()
+	}
+
+	return nil, false
+}
+
+// WriteHeader records the status code before writing it. Once written, later
+// status codes are ignored rather than passed to the wrapped writer.
+//
+// NOTE - Informational status codes (1xx), i.e. `103 - Early Hints`, are passed
+// to the wrapped writer without being recorded
+func (w *ResponseWriterRecorder) WriteHeader(statusCode int) {
+	if w.statusCode != 0 {
+		return
+	}
+
+	if isInformational(statusCode) {
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+
+	w.statusCode = statusCode
+	w.firstWriteAt = time.Now()
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write records the number of bytes written
+func (w *ResponseWriterRecorder) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+		w.firstWriteAt = time.Now()
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.bytesWritten += n
+
+	return n, err
+}
+
+// Flush flushes the wrapped writer if it implements `http.Flusher`
+func (w *ResponseWriterRecorder) Flush() {
+	flushWriter(w.ResponseWriter)
+}
+
+// Unwrap returns the wrapped writer, used by `http.ResponseController`
+func (w *ResponseWriterRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// StatusCode returns the status code written, `0` if nothing has been written
+func (w *ResponseWriterRecorder) StatusCode() int {
+	return w.statusCode
+}
+
+// BytesWritten returns the number of body bytes written
+func (w *ResponseWriterRecorder) BytesWritten() int {
+	return w.bytesWritten
+}
+
+// FirstWriteAt returns when the status code or body was first written, the
+// zero time if nothing has been written
+func (w *ResponseWriterRecorder) FirstWriteAt() time.Time {
+	return w.firstWriteAt
+}
+
+// Written returns whether the status code or body has been written
+func (w *ResponseWriterRecorder) Written() bool {
+	return w.statusCode != 0
+}
+
+// Replied returns whether a replier has sent a response through the recorder. A
+// written response that was not replied was sent without the replier.
+func (w *ResponseWriterRecorder) Replied() bool {
+	return w.replied
+}
+
+// markReplied records that the replier is sending a response through the
+// writer, if it is (or wraps) a recorder
+func markReplied(w http.ResponseWriter) {
+	if recorder, ok := GetResponseWriterRecorder(w); ok {
+		recorder.replied = true
+	}
+}
+
+// runPostSendHooks calls the replier's post send hooks with the response's result
+func (r *Replier) runPostSendHooks(response *NewResponseRequest) {
+	for _, hook := range r.postSendHooks {
+		hook(response, *response.result)
+	}
+}
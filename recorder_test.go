@@ -0,0 +1,107 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseWriterRecorder(t *testing.T) {
+
+	tests := []struct {
+		name                 string
+		handler              func(replier *reply.Replier, w http.ResponseWriter)
+		expectedStatusCode   int
+		expectedBytesWritten int
+		expectedWritten      bool
+		expectedReplied      bool
+	}{
+		{
+			name:    "Success - Nothing written",
+			handler: func(replier *reply.Replier, w http.ResponseWriter) {},
+		},
+		{
+			name: "Success - Written by replier",
+			handler: func(replier *reply.Replier, w http.ResponseWriter) {
+				_ = replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser())
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedBytesWritten: len(stringWithNewLine(getDataResponseBody())),
+			expectedWritten:      true,
+			expectedReplied:      true,
+		},
+		{
+			name: "Success - Written bypassing replier",
+			handler: func(replier *reply.Replier, w http.ResponseWriter) {
+				_, _ = w.Write([]byte("hello"))
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedBytesWritten: 5,
+			expectedWritten:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := reply.NewResponseWriterRecorder(httptest.NewRecorder())
+			before := time.Now()
+
+			test.handler(reply.NewReplier(getEmptyErrorManifest()), recorder)
+
+			assert.Equal(t, test.expectedStatusCode, recorder.StatusCode())
+			assert.Equal(t, test.expectedBytesWritten, recorder.BytesWritten())
+			assert.Equal(t, test.expectedWritten, recorder.Written())
+			assert.Equal(t, test.expectedReplied, recorder.Replied())
+			if test.expectedWritten {
+				assert.False(t, recorder.FirstWriteAt().Before(before))
+				return
+			}
+			assert.True(t, recorder.FirstWriteAt().IsZero())
+		})
+	}
+}
+
+func TestGetResponseWriterRecorder(t *testing.T) {
+
+	recorder := reply.NewResponseWriterRecorder(httptest.NewRecorder())
+
+	found, ok := reply.GetResponseWriterRecorder(http.ResponseWriter(reply.NewResponseWriterRecorder(recorder)))
+	assert.True(t, ok)
+	assert.NotSame(t, recorder, found)
+
+	_, ok = reply.GetResponseWriterRecorder(httptest.NewRecorder())
+	assert.False(t, ok)
+}
+
+func TestReplier_WithPostSendHooks(t *testing.T) {
+
+	var results []reply.ResponseResult
+	var writers []http.ResponseWriter
+
+	replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithPostSendHooks(func(response *reply.NewResponseRequest, result reply.ResponseResult) {
+		results = append(results, result)
+		writers = append(writers, response.Writer)
+	}))
+
+	recorder := reply.NewResponseWriterRecorder(httptest.NewRecorder())
+	err := replier.NewHTTPErrorResponse(recorder, errors.New("example-404-error"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []reply.ResponseResult{
+		{
+			StatusCode:   http.StatusNotFound,
+			BytesWritten: recorder.BytesWritten(),
+			ContentType:  "application/json",
+		},
+	}, results)
+	assert.Equal(t, []http.ResponseWriter{recorder}, writers)
+}
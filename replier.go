@@ -5,12 +5,12 @@
 package reply
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"log"
+	"html/template"
 	"net/http"
-	"strconv"
+	"regexp"
+	"time"
 )
 
 // TransferObjectError outlines expected methods of a transfer object error
@@ -54,6 +54,9 @@ const (
 
 	// defaultErrorsStatusCode is the default status code for errors
 	defaultErrorsStatusCode = http.StatusBadRequest
+
+	// defaultContentType is the default response content type
+	defaultContentType = "application/json"
 )
 
 // Option used to build on top of default Replier features
@@ -66,6 +69,16 @@ func WithTransferObject(replacementTransferObject TransferObject) Option {
 	}
 }
 
+// WithFallbackErrorManifestItem sets the error manifest item returned when an
+// error does not have a corresponding entry in the error manifest.
+//
+// NOTE - If the item's status code is not set, `500` will be used
+func WithFallbackErrorManifestItem(item ErrorManifestItem) Option {
+	return func(r *Replier) {
+		r.fallbackErrorManifestItem = item
+	}
+}
+
 // WithTransferObjectError sets the transfer object error used to represent
 // errors in response
 func WithTransferObjectError(replacementTransferObjectError TransferObjectError) Option {
@@ -86,6 +99,157 @@ type NewResponseRequest struct {
 	Errors     []error
 	TokenOne   string
 	TokenTwo   string
+
+	// HeaderValues holds multi-value headers that replace any values already
+	// set, AddedHeaders multi-value headers appended to them, see
+	// WithHeaderValues and WithAddedHeaders
+	HeaderValues http.Header
+	AddedHeaders http.Header
+
+	// Tokens holds named tokens returned alongside (or instead of) TokenOne
+	// and TokenTwo, see NewHTTPTokensResponse
+	Tokens map[string]string
+
+	// FallbackErrorManifestItem overrides the replier's fallback error manifest
+	// item for this response only
+	FallbackErrorManifestItem *ErrorManifestItem
+
+	// ErrorParams holds the values used to fill placeholders in the title and
+	// detail of the response's error(s)
+	ErrorParams map[string]interface{}
+
+	// AcceptLanguage holds the request's `Accept-Language` header value used to
+	// negotiate the language of the response's error(s)
+	AcceptLanguage string
+
+	// Debug enables debug information on the response's 5xx error(s), regardless
+	// of the replier's configuration
+	Debug bool
+
+	// Context holds the request's context, made available to hooks
+	Context context.Context
+
+	// Links holds the response's link relations, used by transfer objects
+	// implementing TransferObjectLinks
+	Links map[string][]HALLink
+
+	// Embedded holds the response's embedded resources, used by transfer objects
+	// implementing TransferObjectLinks
+	Embedded map[string]interface{}
+
+	// CSVHeader holds the header row of CSV responses, CSVOmitHeader removes it
+	CSVHeader     []string
+	CSVOmitHeader bool
+
+	// Filename sets the `Content-Disposition` header so the response is
+	// downloaded as an attachment
+	Filename string
+
+	// Accept holds the request's `Accept` header value, used to negotiate
+	// whether errors are rendered as HTML pages
+	Accept string
+
+	// UnwrappedData writes the response's data at the top level of the body,
+	// rather than nested under the transfer object's data
+	UnwrappedData bool
+
+	// Flush sends the response to the client as soon as it is written, if the
+	// writer supports it
+	Flush bool
+
+	// Method holds the method of the request being responded to, responses to
+	// `HEAD` requests are written without a body
+	Method string
+
+	// Origin holds the request's `Origin` header value, used to apply the
+	// replier's CORS policy
+	Origin string
+
+	// APIVersion holds the API version the response is shaped for
+	APIVersion string
+
+	// MetaReplace restores replace semantics for meta, see WithMetaReplace
+	MetaReplace bool
+
+	// TypedMeta holds the response's meta when passed as a struct, rather than
+	// a map, see WithMeta
+	TypedMeta interface{}
+
+	// ResourceID holds the id of the resource created, see NewHTTPCreatedResponse
+	ResourceID string
+
+	// LastModified holds when the response's resource was last modified, and
+	// IfModifiedSince the request's `If-Modified-Since` header value, used to
+	// send `304 - Not Modified` responses
+	LastModified    time.Time
+	IfModifiedSince string
+
+	// ContentType overrides the replier's content type for the response
+	ContentType string
+
+	// WithoutDefaultContentType skips setting the replier's content type when
+	// none is set, see WithoutDefaultContentType
+	WithoutDefaultContentType bool
+
+	// Signer signs the response's body, see WithSignature
+	Signer Signer
+
+	// IdempotencyKey holds the request's `Idempotency-Key` header value, see
+	// WithIdempotencyKey
+	IdempotencyKey string
+
+	// Request holds the incoming request being responded to, see WithRequest
+	Request *http.Request
+
+	// DataStream holds the items of the response's data, encoded as they are
+	// received, see NewHTTPDataStreamResponse
+	DataStream <-chan interface{}
+
+	// Stream holds the events of a streamed response, framed using StreamMode,
+	// see NewHTTPStreamResponse
+	Stream     <-chan StreamEvent
+	StreamMode StreamMode
+
+	// ErrorMeta holds request specific meta added to the response's error(s),
+	// see WithErrorMeta
+	ErrorMeta interface{}
+
+	// FieldErrors holds the field errors added to the response's error(s), see
+	// WithFieldErrors
+	FieldErrors map[string]string
+
+	// Profile holds the name of the manifest profile used to resolve the
+	// response's error(s), see WithManifestProfile
+	Profile string
+
+	// Partial sends the response's data and errors together, see
+	// NewHTTPPartialResponse
+	Partial bool
+
+	// Warnings holds the non-fatal errors returned alongside the response's
+	// data, see WithWarnings
+	Warnings []error
+
+	// result is populated with the outcome of the response once sent
+	result *ResponseResult
+
+	// meta holds the response's built meta, set on the transfer object
+	meta map[string]interface{}
+
+	// transferObject and transferObjectError hold the transfer objects used to
+	// build the response, and transferObjectSource the replier transfer object
+	// the response's transfer object was taken for
+	transferObject       TransferObject
+	transferObjectError  TransferObjectError
+	transferObjectSource TransferObject
+
+	// encoder encodes the response's body in place of the replier's encoder,
+	// i.e. for CSV responses
+	encoder Encoder
+
+	// contentLanguage is the language the response's error(s) were translated
+	// into, if any
+	contentLanguage string
 }
 
 // Replier handles managing responses
@@ -100,6 +264,166 @@ type Replier struct {
 
 	// Error object base used to shape error objects in response
 	transferObjectError TransferObjectError
+
+	// Pools of transfer objects reused between responses
+	transferObjectPools *transferObjectPools
+
+	// Meta providers evaluated for every response, their values sit beneath
+	// any meta passed with the response request
+	defaultMetaProviders []MetaProvider
+
+	// Error manifest item used when an error does not have a corresponding
+	// entry in the error manifest
+	fallbackErrorManifestItem ErrorManifestItem
+
+	// Error manifest items used for errors caused by a context finishing
+	// early, that do not have their own manifest entry
+	deadlineExceededErrorManifestItem ErrorManifestItem
+	canceledErrorManifestItem         ErrorManifestItem
+
+	// Status code used for error manifest items that have not set their own
+	// status code
+	defaultErrorStatusCode int
+
+	// Policies used to resolve the status code of error manifest items that
+	// have not set their own status code
+	statusCodePolicies []StatusCodePolicy
+
+	// Prefixes of the error manifest's wildcard keys, i.e. `validation/*`,
+	// ordered by match precedence
+	wildcardPrefixes []string
+
+	// Error manifest items matched using regular expressions, in the order
+	// they are attempted
+	regexpErrorManifest []RegexpErrorManifestItem
+
+	// Whether identical errors are removed from multi error responses, and
+	// if the number of repeats should be added to the remaining error
+	deduplicateErrors   bool
+	countRepeatedErrors bool
+
+	// Order of errors in multi error responses
+	errorSortOrder ErrorSortOrder
+
+	// Localizer used to translate error manifest items, and the languages
+	// supported across the localizer and manifest translations
+	localizer          Localizer
+	supportedLanguages []string
+
+	// Whether the underlying error (and optionally the stack trace) is added to
+	// the meta of 5xx errors
+	debug      bool
+	debugStack bool
+
+	// Sanitizer used to scrub resolved manifest items before they are returned
+	sanitizer Sanitizer
+
+	// Reporter that receives 5xx and unmatched errors
+	reporter Reporter
+
+	// Content type set on responses that have not set their own
+	contentType string
+
+	// Templates used to render error pages for each status class, and when
+	// they should be used
+	htmlErrorTemplates map[StatusClass]*template.Template
+	htmlErrorMode      HTMLErrorMode
+
+	// Context values copied into response headers
+	contextHeaders []contextHeader
+
+	// Whether every response is flushed to the client once written
+	autoFlush bool
+
+	// Encoder used to encode transfer objects
+	encoder Encoder
+
+	// Whether data responses are written without the transfer object envelope
+	unwrappedData bool
+
+	// Names of the default transfer object's envelope keys, nil if not
+	// configured
+	envelopeKeys *EnvelopeKeys
+
+	// How data is rendered for responses without data
+	emptyDataMode EmptyDataMode
+
+	// Attributes of the cookies tokens are delivered in, nil if tokens are
+	// returned in the response body
+	tokenCookieOptions *CookieOptions
+
+	// CORS policy applied to responses, nil if not configured
+	corsPolicy *CORSPolicy
+
+	// Transfer objects used for each API version, and those used for
+	// responses without a registered version
+	versionedTransferObjects map[string]TransferObjectPair
+	defaultTransferObjects   TransferObjectPair
+
+	// Transfer objects used for responses of each status class
+	statusClassTransferObjects map[StatusClass]TransferObjectPair
+
+	// How manifests holding the same key are merged
+	mergePolicy MergePolicy
+
+	// Which error in a wrapped error chain is matched first
+	unwrapPolicy UnwrapPolicy
+
+	// How errors without a manifest entry are handled in multi error responses
+	unmatchedErrorPolicy UnmatchedErrorPolicy
+
+	// Kinds of response generated, ordered by precedence
+	responsePrecedence []ResponseKind
+
+	// Resolves the status code of multi error responses, nil to use the
+	// first error's status code
+	multiErrorStatusStrategy MultiErrorStatusStrategy
+
+	// Pattern every manifest item's code must match, nil if not enforced
+	errorCodePattern *regexp.Regexp
+
+	// Manifest keys used by the not found and method not allowed handlers,
+	// empty to use the built-in keys
+	notFoundManifestKey         string
+	methodNotAllowedManifestKey string
+
+	// Hooks called after each response is sent
+	postSendHooks []PostSendHook
+
+	// Snapshot of the last rendered response, nil if not capturing
+	capture *Capture
+
+	// Duration write deadlines are extended by for each response, zero to
+	// leave deadlines unchanged
+	writeDeadline time.Duration
+
+	// Store responses sent with an idempotency key are kept in and replayed
+	// from, nil to leave responses unsaved
+	idempotencyStore IdempotencyStore
+
+	// Request headers copied onto responses generated with WithRequest
+	propagatedHeaders []string
+
+	// Sink receiving an audit record of each response, and the function used
+	// to get the record's actor
+	auditSink  AuditSink
+	auditActor AuditActorFunc
+
+	// Whether HTML characters are escaped in JSON responses, nil if not
+	// configured
+	jsonEscapeHTML *bool
+
+	// Maximum size of encoded response bodies, zero if not limited, and how
+	// oversized responses are handled
+	maxBodySize    int
+	bodySizePolicy BodySizePolicy
+
+	// How often streamed responses are flushed, zero to flush after every
+	// event
+	streamFlushInterval time.Duration
+
+	// Named sets of manifests chosen per response, see WithManifestProfile
+	manifestProfiles map[string]*manifestProfile
 }
 
 // NewReplier returns a new Replier pointer that shapes and handles both
@@ -113,9 +437,16 @@ func NewReplier(manifests []ErrorManifest, options ...Option) *Replier {
 	activeTransferObjectError := &defaultReplyTransferObjectError{}
 
 	replier := Replier{
-		errorManifest:       mergeManifestCollections(manifests),
-		transferObject:      activeTransferObject,
-		transferObjectError: activeTransferObjectError,
+		transferObject:                    activeTransferObject,
+		transferObjectError:               activeTransferObjectError,
+		transferObjectPools:               &transferObjectPools{},
+		fallbackErrorManifestItem:         getInternalServertErrorManifestItem(),
+		deadlineExceededErrorManifestItem: getDeadlineExceededErrorManifestItem(),
+		canceledErrorManifestItem:         getCanceledErrorManifestItem(),
+		defaultErrorStatusCode:            defaultErrorsStatusCode,
+		contentType:                       defaultContentType,
+		propagatedHeaders:                 defaultPropagatedHeaders,
+		encoder:                           &jsonEncoder{},
 	}
 
 	// Add option add-ons on replier
@@ -123,6 +454,16 @@ func NewReplier(manifests []ErrorManifest, options ...Option) *Replier {
 		option(&replier)
 	}
 
+	replier.errorManifest = replier.mergeErrorManifests(manifests)
+	replier.applyErrorStatusCodePolicies()
+	replier.buildManifestProfiles()
+	replier.validateErrorCodes()
+	replier.configureEncoder()
+	replier.applyEnvelopeKeys()
+	replier.setDefaultTransferObjects()
+	replier.wildcardPrefixes = getWildcardPrefixes(replier.errorManifest)
+	replier.supportedLanguages = replier.getSupportedLanguages()
+
 	return &replier
 }
 
@@ -141,173 +482,395 @@ func NewReplier(manifests []ErrorManifest, options ...Option) *Replier {
 //
 // - The default response will be to return 200 status code if the NewResponseRequest is
 // solely  passed  with a writer
-func (r *Replier) NewHTTPResponse(response *NewResponseRequest) error {
+//
+// - A response is only sent once per writer if the writer reports whether it has
+// been written, see ErrResponseAlreadyWritten. Responding twice through a plain
+// `http.ResponseWriter` is not detected
+func (r *Replier) NewHTTPResponse(response *NewResponseRequest) (err error) {
 
 	if response.Writer == nil {
-		return errors.New("reply/http-response: failed to send response, no writer provided")
+		return fmt.Errorf("reply/http-response: failed to send response, %w", ErrNoWriter)
+	}
+
+	if err := checkResponseContext(response); err != nil {
+		return err
+	}
+
+	if err := checkResponseWritable(response); err != nil {
+		return err
 	}
 
 	// Use fresh transfer object
-	r.transferObject = r.transferObject.RefreshTransferObject()
+	r.selectTransferObjects(response)
+	defer r.releaseTransferObject(response)
+
+	if err := r.mergeTypedMeta(response); err != nil {
+		return err
+	}
 
-	r.setUniversalAttributes(response.Writer, response.Headers, response.Meta, response.StatusCode)
+	markReplied(response.Writer)
 
-	// Manage response for multi errors
-	if len(response.Errors) > 0 {
-		return r.generateMultiErrorResponse(response.Errors)
+	if r.auditSink != nil {
+		if response.result == nil {
+			response.result = &ResponseResult{}
+		}
+		defer r.audit(response, time.Now())
 	}
 
-	// Manage response for error
-	if response.Error != nil {
-		return r.generateErrorResponse(response.Error)
+	if len(r.postSendHooks) > 0 {
+		if response.result == nil {
+			response.result = &ResponseResult{}
+		}
+		defer r.runPostSendHooks(response)
 	}
 
-	// Manage response for token
-	if response.TokenOne != "" || response.TokenTwo != "" {
-		return r.generateTokenResponse(response.TokenOne, response.TokenTwo)
+	if response.result != nil {
+		defer recordResponseResult(response)()
 	}
 
-	// Manage response for data
-	if response.Data != nil {
-		return r.generateDataResponse(response.Data)
+	if isHeadRequest(response) {
+		finishHeadResponse := suppressResponseBody(response)
+		defer func() { finishHeadResponse(err) }()
 	}
 
-	return r.generateDefaultResponse()
+	if response.Signer != nil {
+		finishSignedResponse := signResponseBody(response)
+		defer func() {
+			if signErr := finishSignedResponse(err); err == nil {
+				err = signErr
+			}
+		}()
+	}
+
+	if response.IdempotencyKey != "" {
+		if replayed, err := r.replayIdempotentResponse(response); replayed {
+			return err
+		}
+		defer r.prepareIdempotentResponse(response)()
+	}
+
+	r.setUniversalAttributes(response)
+	r.setLinks(response)
+	setLastModified(response)
+
+	if isNotModified(response) {
+		sendNotModifiedResponse(response)
+		return nil
+	}
+
+	r.extendWriteDeadline(response.Writer)
+
+	if err := r.generateResponse(response); err != nil {
+		return err
+	}
+
+	r.flushResponse(response)
+
+	return nil
+}
+
+// generateResponse generates the appropriate response based on the
+// response attributes
+func (r *Replier) generateResponse(response *NewResponseRequest) error {
+
+	// Manage response for partial success
+	if response.Partial {
+		return r.generatePartialResponse(response)
+	}
+
+	kind, ok := r.getResponseKind(response)
+	if !ok {
+		return r.generateDefaultResponse(response)
+	}
+
+	switch kind {
+	case ResponseKindErrors:
+		// Manage response for multi errors
+		return r.generateMultiErrorResponse(response)
+	case ResponseKindError:
+		// Manage response for error
+		return r.generateErrorResponse(response)
+	case ResponseKindTokens:
+		// Manage response for token
+		return r.generateTokenResponse(response)
+	case ResponseKindStream:
+		// Manage response for streamed events
+		return r.generateStreamResponse(response)
+	case ResponseKindDataStream:
+		// Manage response for streamed data
+		return r.generateDataStreamResponse(response)
+	}
+
+	// Manage response for data
+	return r.generateDataResponse(response)
 }
 
 // generateDefaultResponse generates the default response
-func (r *Replier) generateDefaultResponse() error {
-	r.transferObject.SetData(defaultResponseBody)
+func (r *Replier) generateDefaultResponse(response *NewResponseRequest) error {
+	response.transferObject.SetData(r.getEmptyData())
 
-	return sendHTTPResponse(r.transferObject.GetWriter(), r.transferObject)
+	return r.sendHTTPResponse(response)
 }
 
 // generateDataResponse generates response based on passed data
-func (r *Replier) generateDataResponse(data interface{}) error {
-	r.transferObject.SetData(data)
+func (r *Replier) generateDataResponse(response *NewResponseRequest) error {
+	if r.isDataUnwrapped(response) {
+		return r.writeHTTPResponse(response, response.transferObject.GetStatusCode(), response.Data)
+	}
+
+	response.transferObject.SetData(response.Data)
+	r.truncateTransferObjectData(response)
+	r.setTransferObjectWarnings(response)
 
-	return sendHTTPResponse(r.transferObject.GetWriter(), r.transferObject)
+	return r.sendHTTPResponse(response)
 }
 
-// generateTokenResponse generates token response on passed tokens information
-func (r *Replier) generateTokenResponse(tokenOne, tokenTwo string) error {
-	r.transferObject.SetTokenOne(tokenOne)
-	r.transferObject.SetTokenTwo(tokenTwo)
+// generateTokenResponse generates token response on passed tokens information,
+// or a blank response when tokens are delivered as cookies
+func (r *Replier) generateTokenResponse(response *NewResponseRequest) error {
+	if r.tokenCookieOptions != nil {
+		r.setTokenCookies(response)
+		return r.generateDefaultResponse(response)
+	}
+
+	r.setTransferObjectTokens(response)
 
-	return sendHTTPResponse(r.transferObject.GetWriter(), r.transferObject)
+	return r.sendHTTPResponse(response)
 }
 
 // generateMultiErrorResponse generates error response for multiple
 // errors
 //
 // NOTE - If at anytime one of the errors return a 5XX error manifest item,
-// only the 5XX error will be returned
-func (r *Replier) generateMultiErrorResponse(errs []error) error {
+// only the 5XX error will be returned. Errors without a manifest entry are
+// handled using the replier's unmatched error policy.
+func (r *Replier) generateMultiErrorResponse(response *NewResponseRequest) error {
 
-	transferObjectErrors := []TransferObjectError{}
+	transferObjectErrors := make([]TransferObjectError, 0, len(response.Errors))
 
-	for _, err := range errs {
-		manifestItem := r.getErrorManifestItem(err)
+	var droppedErr error
+	var droppedItem ErrorManifestItem
 
-		if is5xx(manifestItem.StatusCode) {
-			return r.sendHTTPErrorsResponse(manifestItem.StatusCode, append(
-				[]TransferObjectError{},
-				r.convertErrorManifestItemToTransferObjectError(manifestItem)))
+	for _, err := range response.Errors {
+		manifestItem, matched := r.resolveSentErrorManifestItem(err, response)
+
+		if !matched && r.unmatchedErrorPolicy == UnmatchedErrorsDrop {
+			if droppedErr == nil {
+				droppedErr, droppedItem = err, manifestItem
+			}
+			continue
 		}
 
-		transferObjectErrors = append(transferObjectErrors, r.convertErrorManifestItemToTransferObjectError(manifestItem))
+		if is5xx(manifestItem.StatusCode) && (matched || r.unmatchedErrorPolicy == UnmatchedErrorsCollapse) {
+			return r.sendHTTPErrorsResponse(response, manifestItem.StatusCode, []TransferObjectError{
+				r.buildTransferObjectError(err, manifestItem, response)})
+		}
+
+		transferObjectErrors = append(transferObjectErrors, r.buildTransferObjectError(err, manifestItem, response))
 	}
 
-	statusCode := getAppropiateStatusCodeOrDefault(transferObjectErrors)
+	if len(transferObjectErrors) == 0 && droppedErr != nil {
+		return r.sendHTTPErrorsResponse(response, droppedItem.StatusCode, []TransferObjectError{
+			r.buildTransferObjectError(droppedErr, droppedItem, response)})
+	}
 
-	return r.sendHTTPErrorsResponse(statusCode, transferObjectErrors)
+	transferObjectErrors = r.organiseTransferObjectErrors(transferObjectErrors)
+
+	statusCode := r.getMultiErrorStatusCode(transferObjectErrors)
+
+	return r.sendHTTPErrorsResponse(response, statusCode, transferObjectErrors)
 }
 
 // generateErrorResponse generates correct error response based on passed
 // error
-func (r *Replier) generateErrorResponse(err error) error {
-	manifestItem := r.getErrorManifestItem(err)
+func (r *Replier) generateErrorResponse(response *NewResponseRequest) error {
+	manifestItem, _ := r.resolveSentErrorManifestItem(response.Error, response)
 
-	transferObjectErrors := append([]TransferObjectError{}, r.convertErrorManifestItemToTransferObjectError(manifestItem))
+	transferObjectErrors := []TransferObjectError{r.buildTransferObjectError(response.Error, manifestItem, response)}
 
-	return r.sendHTTPErrorsResponse(manifestItem.StatusCode, transferObjectErrors)
+	return r.sendHTTPErrorsResponse(response, manifestItem.StatusCode, transferObjectErrors)
 }
 
 // sendHTTPErrorsResponse handles setting status code and transfer object errors before
 // attempting to send response
-func (r *Replier) sendHTTPErrorsResponse(statusCode int, transferObjectErrors []TransferObjectError) error {
-	r.transferObject.SetStatusCode(statusCode)
-	r.transferObject.SetErrors(transferObjectErrors)
+func (r *Replier) sendHTTPErrorsResponse(response *NewResponseRequest, statusCode int, transferObjectErrors []TransferObjectError) error {
+	r.applyErrorStatusClassTransferObject(response, statusCode)
 
-	return sendHTTPResponse(r.transferObject.GetWriter(), r.transferObject)
+	response.transferObject.SetStatusCode(statusCode)
+	response.transferObject.SetErrors(transferObjectErrors)
+	response.result.setErrorCodes(transferObjectErrors)
+
+	if r.shouldRenderHTMLError(response, statusCode) {
+		return r.sendHTMLErrorResponse(response, statusCode, transferObjectErrors)
+	}
+
+	return r.sendHTTPResponse(response)
 }
 
 // getErrorManifestItem returns the corresponding manifest Item if found,
-// otherwise the internal server error is returned
-func (r *Replier) getErrorManifestItem(err error) ErrorManifestItem {
-	manifestItem, ok := r.errorManifest[err.Error()]
+// otherwise the fallback error manifest item is returned
+//
+// NOTE - Errors implementing ManifestedError supply their own manifest item
+func (r *Replier) getErrorManifestItem(err error, response *NewResponseRequest) ErrorManifestItem {
+	manifestItem, _ := r.resolveErrorManifestItem(err, response)
+
+	return manifestItem
+}
+
+// resolveErrorManifestItem returns the corresponding manifest item if found,
+// otherwise the fallback error manifest item, and whether one was found
+func (r *Replier) resolveErrorManifestItem(err error, response *NewResponseRequest) (ErrorManifestItem, bool) {
+	manifestItem, ok := getManifestedErrorItem(err)
+	if !ok {
+		manifestItem, ok = r.lookupErrorChainManifestItem(err, response)
+	}
+
+	if !ok {
+		manifestItem, ok = getDefaultItemErrorItem(err)
+	}
+	if !ok {
+		manifestItem, ok = r.getCodedErrorManifestItem(err, response)
+	}
 	if !ok {
-		manifestItem = getInternalServertErrorManifestItem()
-		log.Printf("reply/error-response: failed to find error manifest item for %v", err)
+		manifestItem, ok = r.getContextErrorManifestItem(err, getResponseContext(response))
+	}
+
+	if ok {
+		manifestItem = applyCodedError(manifestItem, err)
+	} else {
+		manifestItem = r.getFallbackErrorManifestItem(response)
 	}
 
-	setDefaultStatusCode(&manifestItem)
+	r.resolveManifestItemStatusCode(err.Error(), &manifestItem)
 
-	return manifestItem
+	language := r.negotiateLanguage(response)
+	manifestItem, translated := r.localizeErrorManifestItem(getResponseContext(response), language, manifestItem)
+	if translated && response != nil {
+		response.contentLanguage = language
+	}
+	manifestItem = applyErrorParams(manifestItem, err, response)
+	manifestItem = applyErrorMeta(manifestItem, response)
+
+	return manifestItem, ok
 }
 
-// setDefaultStatusCode sets the error manifest item's status code to default error
-// code value if it is not already set (non-zero)
-func setDefaultStatusCode(item *ErrorManifestItem) {
-	if item.StatusCode == 0 {
-		item.StatusCode = defaultErrorsStatusCode
+// resolveSentErrorManifestItem resolves the manifest item of an error sent in
+// the response, logging and reporting the error based on its manifest item. For
+// error responses, the language it was translated into, if any, is set as the
+// response's `Content-Language`.
+//
+// NOTE - Errors that are resolved without being sent, i.e. warnings or those
+// passed to ResolveErrorManifestItem, are neither logged nor reported
+func (r *Replier) resolveSentErrorManifestItem(err error, response *NewResponseRequest) (ErrorManifestItem, bool) {
+	manifestItem, ok := r.resolveErrorManifestItem(err, response)
+
+	logError(err, manifestItem, ok)
+	r.reportError(err, manifestItem, ok, response)
+
+	if r.isErrorResponse(response) {
+		r.setContentLanguage(response)
 	}
+
+	return manifestItem, ok
+}
+
+// ResolveErrorManifestItem returns the manifest item the passed error resolves to,
+// as it would be returned in an error response. It can be used to share the
+// replier's error manifest with other protocols, i.e. gRPC.
+//
+// NOTE - If the error doesn't have a manifest entry, the fallback error
+// manifest item will be returned
+func (r *Replier) ResolveErrorManifestItem(err error, attributes ...ResponseAttributes) ErrorManifestItem {
+
+	request := NewResponseRequest{
+		Error: err,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.sanitizeErrorManifestItem(r.getErrorManifestItem(err, &request))
+}
+
+// getFallbackErrorManifestItem returns the fallback error manifest item for the
+// response. The response's override is used if set, otherwise the replier's.
+//
+// NOTE - If the fallback item does not have a status code, `500` is used
+func (r *Replier) getFallbackErrorManifestItem(response *NewResponseRequest) ErrorManifestItem {
+	fallbackItem := r.fallbackErrorManifestItem
+	if response != nil && response.FallbackErrorManifestItem != nil {
+		fallbackItem = *response.FallbackErrorManifestItem
+	}
+
+	if fallbackItem.StatusCode == 0 {
+		fallbackItem.StatusCode = http.StatusInternalServerError
+	}
+
+	return fallbackItem
 }
 
 // setUniversalAttributes sets the attributes that are common across all
 // response types
-func (r *Replier) setUniversalAttributes(writer http.ResponseWriter, headers map[string]string, meta map[string]interface{}, statusCode int) {
-	r.transferObject.SetWriter(writer)
-	r.setHeaders(headers)
-	r.transferObject.SetMeta(meta)
+func (r *Replier) setUniversalAttributes(response *NewResponseRequest) {
+	response.transferObject.SetWriter(response.Writer)
+	setResponseContentType(response)
+	r.setHeaders(response)
+	r.setContextHeaders(response)
+	r.setPropagatedHeaders(response)
+	r.setCORSHeaders(response.Writer, response.Origin)
+	response.meta = r.buildMeta(getResponseContext(response), response.Meta, response.MetaReplace)
+
+	r.setTransferObjectAttributes(response)
+}
+
+// setTransferObjectAttributes sets the response's writer, meta and status code
+// on its transfer object, without re-applying headers or rebuilding meta
+func (r *Replier) setTransferObjectAttributes(response *NewResponseRequest) {
+	response.transferObject.SetWriter(response.Writer)
+	response.transferObject.SetMeta(response.meta)
+	r.setTransferObjectTypedMeta(response)
 
-	if statusCode != 0 {
-		r.transferObject.SetStatusCode(statusCode)
+	if response.StatusCode != 0 {
+		response.transferObject.SetStatusCode(response.StatusCode)
 		return
 	}
 
-	r.transferObject.SetStatusCode(defaultStatusCode)
+	response.transferObject.SetStatusCode(defaultStatusCode)
 }
 
-// setDefaultContentType handles setting default content type to JSON if
-// not already set
-func (r *Replier) setDefaultContentType() {
-	if r.transferObject.GetWriter().Header().Get("Content-type") == "" {
-		r.transferObject.GetWriter().Header().Set("Content-type", "application/json")
+// setDefaultContentType handles setting default content type to the replier's
+// content type (JSON by default) if not already set
+func (r *Replier) setDefaultContentType(response *NewResponseRequest) {
+	header := response.transferObject.GetWriter().Header()
+	if header.Get("Content-type") != "" {
+		return
+	}
+
+	if response.WithoutDefaultContentType {
+		omitContentType(header)
+		return
 	}
+
+	header.Set("Content-type", r.contentType)
 }
 
 // setHeaders handles setting headers on the writer. Existing headers should not
 // be affected unless they share the header key
-func (r *Replier) setHeaders(h map[string]string) {
-
-	r.setDefaultContentType()
+func (r *Replier) setHeaders(response *NewResponseRequest) {
 
-	if h == nil {
-		return
-	}
+	r.setDefaultContentType(response)
 
-	for headerKey, headerValue := range h {
-		r.transferObject.GetWriter().Header().Set(headerKey, headerValue)
-	}
+	writeResponseHeaders(response.transferObject.GetWriter().Header(), response)
 }
 
 // convertErrorManifestItemToTransferObjectError converts manifest error item to valid
 // transfer object error
-func (r *Replier) convertErrorManifestItemToTransferObjectError(errorItem ErrorManifestItem) TransferObjectError {
+func (r *Replier) convertErrorManifestItemToTransferObjectError(errorItem ErrorManifestItem, response *NewResponseRequest) TransferObjectError {
 
 	// Use fresh transfer object error
-	convertedError := r.transferObjectError.RefreshTransferObject()
+	convertedError := r.getStatusClassTransferObjectError(errorItem.StatusCode, response).RefreshTransferObject()
 
 	convertedError.SetTitle(errorItem.Title)
 	convertedError.SetDetail(errorItem.Detail)
@@ -319,24 +882,22 @@ func (r *Replier) convertErrorManifestItemToTransferObjectError(errorItem ErrorM
 	return convertedError
 }
 
-// getAppropiateStatusCodeOrDefault loops through collection of transfer object errors (first to last), and
-// attempts to pull and convert status code (string).
-//
-// NOTE - If error occurs the next element will be attempted. In the event no elements are left, the default
-// error status code (400) will be returned
-func getAppropiateStatusCodeOrDefault(transferObjectErrors []TransferObjectError) int {
+// buildTransferObjectError converts the error's resolved manifest item to a transfer
+// object error, before adding any error specific attributes, i.e. source
+func (r *Replier) buildTransferObjectError(err error, manifestItem ErrorManifestItem, response *NewResponseRequest) TransferObjectError {
 
-	for _, transferObjectError := range transferObjectErrors {
+	manifestItem = r.sanitizeErrorManifestItem(manifestItem)
 
-		statusCode, err := strconv.Atoi(transferObjectError.GetStatusCode())
-		if err != nil {
-			continue
-		}
-
-		return statusCode
+	if is5xx(manifestItem.StatusCode) && r.isDebugEnabled(response) {
+		manifestItem.Meta = mergeErrorMeta(manifestItem.Meta, debugMetaKey, r.buildDebugInformation(err, manifestItem))
 	}
 
-	return defaultErrorsStatusCode
+	transferObjectError := r.convertErrorManifestItemToTransferObjectError(manifestItem, response)
+
+	setTransferObjectErrorSource(transferObjectError, err)
+	setTransferObjectErrorFields(transferObjectError, response)
+
+	return transferObjectError
 }
 
 // is5xx returns whether status code is a 5xx
@@ -349,36 +910,57 @@ func is5xx(statusCode int) bool {
 }
 
 // sendHTTPResponse handles sending response based on the transfer object
-func sendHTTPResponse(writer http.ResponseWriter, transferObject TransferObject) error {
+//
+// NOTE - The transfer object is encoded before the status code is written, so
+// encoding failures do not commit a partial response
+func (r *Replier) sendHTTPResponse(response *NewResponseRequest) error {
+	return r.writeHTTPResponse(response, response.transferObject.GetStatusCode(), getTransferObjectResponseBody(response.transferObject))
+}
 
-	writer.WriteHeader(transferObject.GetStatusCode())
-	err := json.NewEncoder(writer).Encode(transferObject)
-	if err != nil {
-		return fmt.Errorf("reply/http-response: failed to encode transfer object with %v", err)
+// getTransferObjectResponseBody returns the value encoded as the transfer
+// object's response body
+func getTransferObjectResponseBody(transferObject TransferObject) interface{} {
+	if bodyTransferObject, ok := transferObject.(transferObjectBody); ok {
+		return bodyTransferObject.responseBody()
 	}
 
-	return nil
+	return transferObject
 }
 
-// mergeManifestCollections handles merging the passed manifests into a singular
-// manifest
-func mergeManifestCollections(manifests []ErrorManifest) ErrorManifest {
+// transferObjectBody outlines the transfer objects that encode a value other
+// than themselves as the response body
+type transferObjectBody interface {
+	responseBody() interface{}
+}
 
-	mergedManifests := make(ErrorManifest)
+// writeHTTPResponse encodes the body before writing it with the status code
+// to the writer of the response's transfer object
+func (r *Replier) writeHTTPResponse(response *NewResponseRequest, statusCode int, responseBody interface{}) error {
 
-	for _, manifest := range manifests {
-		getManifestItems(manifest, mergedManifests)
-	}
+	writer := response.transferObject.GetWriter()
 
-	return mergedManifests
-}
+	body := getBuffer()
+	defer putBuffer(body)
 
-// getManifestItems pulls the key and items from the manifest and inserts into final manifest
-func getManifestItems(manifest ErrorManifest, finalManifest ErrorManifest) {
+	err := r.getResponseEncoder(response).Encode(body, responseBody)
+	if err != nil {
+		r.sendEncodeFailureResponse(response, err)
+		return &EncodeError{Cause: err}
+	}
 
-	for key, item := range manifest {
-		finalManifest[key] = item
+	if r.isBodyOversized(body) {
+		return r.writeOversizedHTTPResponse(response, statusCode, body)
 	}
+
+	setContentLength(writer, body.Len())
+	writer.WriteHeader(statusCode)
+	if _, err := writer.Write(body.Bytes()); err != nil {
+		return fmt.Errorf("reply/http-response: failed to write response with %v", err)
+	}
+
+	r.captureResponse(writer, statusCode, body.Bytes())
+
+	return nil
 }
 
 // getInternalServertErrorManifestItem returns typical 500 error with text and message
@@ -403,10 +985,64 @@ func WithHeaders(headers map[string]string) ResponseAttributes {
 	}
 }
 
-// WithMeta adds passed meta data on to the generated response
-func WithMeta(meta map[string]interface{}) ResponseAttributes {
+// WithMeta adds passed meta data on to the generated response. Meta can
+// also be passed as a struct, i.e. a service's well-defined meta schema,
+// which is handed as is to transfer objects implementing TransferObjectMeta,
+// or otherwise encoded and merged beneath the response's map meta.
+//
+// NOTE - Repeated calls passing maps are deep merged, nested maps included,
+// with values from later calls taking precedence. The resulting meta then
+// takes precedence over values from the replier's meta providers. See
+// WithMetaReplace to replace meta instead. Structs are not merged, a struct
+// replaces any struct passed by an earlier call.
+func WithMeta(meta interface{}) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		mapMeta, isMap := meta.(map[string]interface{})
+		if !isMap && meta != nil {
+			r.TypedMeta = meta
+			return
+		}
+
+		if r.MetaReplace {
+			r.Meta = mapMeta
+			return
+		}
+
+		r.Meta = mergeMeta(r.Meta, mapMeta)
+	}
+}
+
+// WithMetaEntry adds a single key and value to the generated response's meta.
+// It composes with WithMeta and the replier's meta providers, taking
+// precedence where keys are shared, in the order attributes are passed.
+//
+// NOTE - The entry is set on the response request's meta in place, avoid
+// sharing a request's Meta map between responses
+func WithMetaEntry(key string, value interface{}) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		if r.Meta == nil {
+			r.Meta = make(map[string]interface{}, 1)
+		}
+
+		r.Meta[key] = value
+	}
+}
+
+// WithMetaReplace makes subsequent WithMeta calls replace the response's meta,
+// and the response's meta shallowly overwrite values from the replier's meta
+// providers, rather than deep merging
+func WithMetaReplace() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.MetaReplace = true
+	}
+}
+
+// WithFallbackErrorItem overrides the replier's fallback error manifest item,
+// used when an error does not have a corresponding manifest entry, for the
+// generated response
+func WithFallbackErrorItem(item ErrorManifestItem) ResponseAttributes {
 	return func(r *NewResponseRequest) {
-		r.Meta = meta
+		r.FallbackErrorManifestItem = &item
 	}
 }
 
@@ -516,7 +1152,7 @@ func (r *Replier) NewHTTPBlankResponse(w http.ResponseWriter, statusCode int, at
 func (r *Replier) NewHTTPTokenResponse(w http.ResponseWriter, statusCode int, tokenOne, tokenTwo string, attributes ...ResponseAttributes) error {
 
 	if isEmpty(tokenOne) && isEmpty(tokenTwo) {
-		return errors.New("reply/http-token-aide: failed at least one token must be returned")
+		return fmt.Errorf("reply/http-token-aide: failed %w", ErrNoTokens)
 	}
 
 	request := NewResponseRequest{
@@ -534,6 +1170,18 @@ func (r *Replier) NewHTTPTokenResponse(w http.ResponseWriter, statusCode int, to
 	return r.NewHTTPResponse(&request)
 }
 
+// setResponseHeader sets the header on the response request, copying its
+// headers so maps passed by the caller are not modified
+func setResponseHeader(response *NewResponseRequest, key, value string) {
+	headers := make(map[string]string, len(response.Headers)+1)
+	for headerKey, headerValue := range response.Headers {
+		headers[headerKey] = headerValue
+	}
+	headers[key] = value
+
+	response.Headers = headers
+}
+
 // isEmpty checks if the passed string is empty
 func isEmpty(s string) bool {
 	return s == ""
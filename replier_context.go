@@ -0,0 +1,39 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+	"net/http"
+)
+
+// replierContextKey is the context key the request's replier is stored under
+type replierContextKey struct{}
+
+// NewContext returns a copy of the context holding the passed replier, see
+// FromContext
+func NewContext(ctx context.Context, replier *Replier) context.Context {
+	return context.WithValue(ctx, replierContextKey{}, replier)
+}
+
+// FromContext returns the replier stored in the request's context by NewContext
+// (i.e. by a router middleware), nil if the context does not hold one
+func FromContext(r *http.Request) *Replier {
+	replier, _ := r.Context().Value(replierContextKey{}).(*Replier)
+
+	return replier
+}
+
+// Clone returns a copy of the replier, sharing its configuration and transfer
+// objects.
+//
+// NOTE - Responses are built using their own transfer objects, so a replier
+// can be shared by concurrent requests without cloning it
+func (r *Replier) Clone() *Replier {
+
+	replier := *r
+
+	return &replier
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+
+	replier := reply.NewReplier(getEmptyErrorManifest())
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	assert.Nil(t, reply.FromContext(request))
+	assert.Same(t, replier, reply.FromContext(request.WithContext(reply.NewContext(context.Background(), replier))))
+}
+
+func TestReplier_Clone(t *testing.T) {
+
+	replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithVersionedTransferObjects(map[string]reply.TransferObjectPair{
+		"v2": {TransferObject: &fooReplyTransferObject{}},
+	}))
+	clone := replier.Clone()
+
+	assert.NotSame(t, replier, clone)
+
+	w := httptest.NewRecorder()
+	err := clone.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithAPIVersion("v2"))
+	assert.NoError(t, err)
+	assert.Equal(t, stringWithNewLine(`{"bar":{"data":{"id":"some-id","name":"john doe"}}}`), w.Body.String())
+
+	w = httptest.NewRecorder()
+	err = replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+	assert.NoError(t, err)
+	assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+}
@@ -46,7 +46,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -62,7 +62,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -79,7 +79,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -96,7 +96,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -114,7 +114,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -133,7 +133,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -151,7 +151,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -169,7 +169,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -188,7 +188,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -208,7 +208,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -225,7 +225,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getSingleTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -244,7 +244,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -263,7 +263,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -283,7 +283,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -301,7 +301,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -317,7 +317,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -333,7 +333,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrors()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -349,7 +349,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -366,7 +366,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -383,7 +383,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrors()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -400,7 +400,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -417,7 +417,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -435,7 +435,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -453,7 +453,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -474,7 +474,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrors()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -492,7 +492,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -509,7 +509,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -525,7 +525,7 @@ func TestReplier_NewHTTPResponse(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -581,7 +581,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -595,7 +595,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -612,7 +612,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOne()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -629,7 +629,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -647,7 +647,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -664,7 +664,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -679,7 +679,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -697,7 +697,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -715,7 +715,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -734,7 +734,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -752,7 +752,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -768,7 +768,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -787,7 +787,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -806,7 +806,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -826,7 +826,7 @@ func TestReplier_NewHTTPErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseForExampleErrorOneWithMetaBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -884,7 +884,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrors()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -898,7 +898,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 
@@ -916,7 +916,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrors()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 
@@ -934,7 +934,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 
@@ -953,7 +953,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -970,7 +970,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -985,7 +985,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1003,7 +1003,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1021,7 +1021,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1040,7 +1040,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBodyUsingCustomTOE()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		///////////////////////////////
@@ -1058,7 +1058,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getErrorResponseISEBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1074,7 +1074,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1093,7 +1093,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1112,7 +1112,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1132,7 +1132,7 @@ func TestReplier_NewHTTPMultiErrorResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getMultiErrorResponseMultiErrorsWithMetaBodyUsingCustomTOEAndTO()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -1193,7 +1193,7 @@ func TestReplier_NewHTTPDataResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1212,7 +1212,7 @@ func TestReplier_NewHTTPDataResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1231,7 +1231,7 @@ func TestReplier_NewHTTPDataResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1251,7 +1251,7 @@ func TestReplier_NewHTTPDataResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getDataResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -1313,7 +1313,7 @@ func TestReplier_NewHTTPTokenResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1328,7 +1328,7 @@ func TestReplier_NewHTTPTokenResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getSingleTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1347,7 +1347,7 @@ func TestReplier_NewHTTPTokenResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1366,7 +1366,7 @@ func TestReplier_NewHTTPTokenResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1386,7 +1386,7 @@ func TestReplier_NewHTTPTokenResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getFullTokenResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -1443,7 +1443,7 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1457,7 +1457,7 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1474,7 +1474,7 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1491,7 +1491,7 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getDefaultHeader(), w.Header())
+				assert.Equal(t, getDefaultHeader(w.Body.Len()), w.Header())
 			},
 		},
 		{
@@ -1509,7 +1509,7 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 
 				assert.Equal(t, stringWithNewLine(getBlankResponseWithMetaBody()), returnedBody)
 
-				assert.Equal(t, getAdditionalHeaders(), w.Header())
+				assert.Equal(t, getAdditionalHeaders(w.Body.Len()), w.Header())
 			},
 		},
 	}
@@ -1544,19 +1544,90 @@ func TestReplier_NewHTTPBlankResponseAide(t *testing.T) {
 	}
 }
 
+func TestReplier_FallbackErrorManifestItem(t *testing.T) {
+
+	customFallback := reply.ErrorManifestItem{Title: "Something Went Wrong", Code: "E500", About: "www.example.com/reply/errors/E500"}
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		responseAttributes []reply.ResponseAttributes
+		err                error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Default fallback used for missing manifest entry",
+			err:                errors.New("example-missing-error"),
+			expectedBody:       getErrorResponseISEBody(),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:               "Success - Replier fallback used for missing manifest entry",
+			options:            []reply.Option{reply.WithFallbackErrorManifestItem(customFallback)},
+			err:                errors.New("example-missing-error"),
+			expectedBody:       `{"errors":[{"title":"Something Went Wrong","about":"www.example.com/reply/errors/E500","status":"500","code":"E500"}]}`,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:    "Success - Response fallback overrides replier fallback",
+			options: []reply.Option{reply.WithFallbackErrorManifestItem(customFallback)},
+			responseAttributes: []reply.ResponseAttributes{
+				reply.WithFallbackErrorItem(reply.ErrorManifestItem{Title: "Upstream Unavailable", StatusCode: http.StatusServiceUnavailable}),
+			},
+			err:                errors.New("example-missing-error"),
+			expectedBody:       `{"errors":[{"title":"Upstream Unavailable","status":"503"}]}`,
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:               "Success - Fallback not used for matched manifest entry",
+			options:            []reply.Option{reply.WithFallbackErrorManifestItem(customFallback)},
+			err:                getExampleErrorOne(),
+			expectedBody:       getErrorResponseForExampleErrorOne(),
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err, test.responseAttributes...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_EncodeFailureSendsFallbackErrorResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	replier := reply.NewReplier(getEmptyErrorManifest())
+
+	err := replier.NewHTTPDataResponse(w, http.StatusCreated, make(chan int))
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, stringWithNewLine(getErrorResponseISEBody()), w.Body.String())
+}
+
 // stringWithNewLine appends new line to passed string
 func stringWithNewLine(s string) string {
 	return fmt.Sprintf("%s\n", s)
 }
 
-// getDefaultHeader returns default headers
-func getDefaultHeader() http.Header {
-	return http.Header{"Content-Type": []string{"application/json"}}
+// getDefaultHeader returns default headers for a body of the passed length
+func getDefaultHeader(contentLength int) http.Header {
+	return http.Header{"Content-Type": []string{"application/json"}, "Content-Length": []string{strconv.Itoa(contentLength)}}
 }
 
 // getAdditionalHeaders returns default header with addition correlation ID header
-func getAdditionalHeaders() http.Header {
-	return http.Header{"Content-Type": []string{"application/json"}, "Correlation-Id": []string{"some-id"}}
+func getAdditionalHeaders(contentLength int) http.Header {
+	return http.Header{"Content-Type": []string{"application/json"}, "Content-Length": []string{strconv.Itoa(contentLength)}, "Correlation-Id": []string{"some-id"}}
 }
 
 // getEmptyErrorManifest returns an empty manifest
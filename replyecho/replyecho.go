@@ -0,0 +1,104 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replyecho integrates reply with echo. Its aides respond through an
+// `echo.Context`, while its HTTP error handler converts `echo.HTTPError` and
+// handler errors using the replier's error manifest, so echo services return
+// the same error bodies as any other reply service.
+package replyecho
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ooaklee/reply"
+)
+
+// HTTPErrorHandler returns an echo error handler responding with the replier.
+//
+// Errors returned by handlers are resolved using the error manifest. An
+// `echo.HTTPError` is resolved using its internal error when it has one,
+// otherwise using the `http-<status code>` manifest key (i.e. `http-413`),
+// falling back to an item built from its status code and message. The
+// `not-found`, `method-not-allowed` and `unauthorized` keys are used for the
+// matching status codes, see reply.ErrNotFound.
+func HTTPErrorHandler(replier *reply.Replier) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		_ = Error(c, replier, convertError(err))
+	}
+}
+
+// MultiError sends a multi error response, see reply.Replier.NewHTTPMultiErrorResponse
+func MultiError(c echo.Context, replier *reply.Replier, errs []error, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPMultiErrorResponse(c.Response(), errs, withRequest(c, attributes)...)
+}
+
+// Error sends an error response, see reply.Replier.NewHTTPErrorResponse
+func Error(c echo.Context, replier *reply.Replier, err error, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPErrorResponse(c.Response(), err, withRequest(c, attributes)...)
+}
+
+// Data sends a data response, see reply.Replier.NewHTTPDataResponse
+func Data(c echo.Context, replier *reply.Replier, statusCode int, data interface{}, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPDataResponse(c.Response(), statusCode, data, withRequest(c, attributes)...)
+}
+
+// Blank sends a blank response, see reply.Replier.NewHTTPBlankResponse
+func Blank(c echo.Context, replier *reply.Replier, statusCode int, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPBlankResponse(c.Response(), statusCode, withRequest(c, attributes)...)
+}
+
+// Token sends a token response, see reply.Replier.NewHTTPTokenResponse
+func Token(c echo.Context, replier *reply.Replier, statusCode int, tokenOne, tokenTwo string, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPTokenResponse(c.Response(), statusCode, tokenOne, tokenTwo, withRequest(c, attributes)...)
+}
+
+// withRequest returns the attributes preceded by the request's context and
+// method, so the passed attributes take precedence
+func withRequest(c echo.Context, attributes []reply.ResponseAttributes) []reply.ResponseAttributes {
+	return append([]reply.ResponseAttributes{
+		reply.WithContext(c.Request().Context()),
+		reply.WithMethod(c.Request().Method),
+	}, attributes...)
+}
+
+// convertError returns the error resolved by the replier for the error returned
+// by an echo handler
+func convertError(err error) error {
+
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		return err
+	}
+
+	if httpErr.Internal != nil {
+		return httpErr.Internal
+	}
+
+	switch httpErr.Code {
+	case http.StatusNotFound:
+		return reply.ErrNotFound
+	case http.StatusMethodNotAllowed:
+		return reply.ErrMethodNotAllowed
+	case http.StatusUnauthorized:
+		return reply.ErrUnauthorized
+	}
+
+	item := reply.ErrorManifestItem{
+		Title:      http.StatusText(httpErr.Code),
+		StatusCode: httpErr.Code,
+	}
+
+	if message := fmt.Sprint(httpErr.Message); message != item.Title {
+		item.Detail = message
+	}
+
+	return reply.NewErrorWithDefaultItem(fmt.Sprintf("http-%d", httpErr.Code), item)
+}
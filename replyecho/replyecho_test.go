@@ -0,0 +1,177 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replyecho_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replyecho"
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestManifest returns the manifest used by tests
+func getTestManifest() []reply.ErrorManifest {
+	return []reply.ErrorManifest{
+		{
+			"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", Code: "U404", StatusCode: http.StatusNotFound},
+			"http-413":          reply.ErrorManifestItem{Title: "Payload Too Large", Code: "P413", StatusCode: http.StatusRequestEntityTooLarge},
+		},
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		path         string
+		handlerErr   error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success - Handler error resolved using manifest",
+			path:         "/",
+			handlerErr:   errors.New("example-404-error"),
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}`,
+		},
+		{
+			name:         "Success - Unmatched route",
+			path:         "/missing",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Not Found","status":"404"}]}`,
+		},
+		{
+			name:         "Success - HTTP error internal error resolved using manifest",
+			path:         "/",
+			handlerErr:   echo.NewHTTPError(http.StatusBadRequest).SetInternal(errors.New("example-404-error")),
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}`,
+		},
+		{
+			name:         "Success - HTTP error status resolved using manifest",
+			path:         "/",
+			handlerErr:   echo.NewHTTPError(http.StatusRequestEntityTooLarge),
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedBody: `{"errors":[{"title":"Payload Too Large","status":"413","code":"P413"}]}`,
+		},
+		{
+			name:         "Success - HTTP error without manifest entry",
+			path:         "/",
+			handlerErr:   echo.NewHTTPError(http.StatusConflict, "user already exists"),
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"errors":[{"title":"Conflict","detail":"user already exists","status":"409"}]}`,
+		},
+		{
+			name:         "Success - Unmanifested error",
+			path:         "/",
+			handlerErr:   fmt.Errorf("unexpected"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"errors":[{"title":"Internal Server Error","status":"500"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			e.HTTPErrorHandler = replyecho.HTTPErrorHandler(reply.NewReplier(getTestManifest()))
+			e.GET("/", func(c echo.Context) error {
+				return test.handlerErr
+			})
+
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, test.expectedBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestAides(t *testing.T) {
+
+	replier := reply.NewReplier(getTestManifest())
+
+	tests := []struct {
+		name         string
+		method       string
+		handler      echo.HandlerFunc
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "Success - Data",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return replyecho.Data(c, replier, http.StatusOK, map[string]string{"id": "some-id"})
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"id":"some-id"}}` + "\n",
+		},
+		{
+			name:   "Success - Data without body for HEAD request",
+			method: http.MethodHead,
+			handler: func(c echo.Context) error {
+				return replyecho.Data(c, replier, http.StatusOK, map[string]string{"id": "some-id"})
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "Success - Error",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return replyecho.Error(c, replier, errors.New("example-404-error"))
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}` + "\n",
+		},
+		{
+			name:   "Success - Multi error",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return replyecho.MultiError(c, replier, []error{errors.New("example-404-error")})
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}` + "\n",
+		},
+		{
+			name:   "Success - Blank",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return replyecho.Blank(c, replier, http.StatusOK)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":"{}"}` + "\n",
+		},
+		{
+			name:   "Success - Token",
+			method: http.MethodGet,
+			handler: func(c echo.Context) error {
+				return replyecho.Token(c, replier, http.StatusOK, "access", "")
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"access_token":"access"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			e.Add(test.method, "/", test.handler)
+
+			w := httptest.NewRecorder()
+			e.ServeHTTP(w, httptest.NewRequest(test.method, "/", nil))
+
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, test.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replyfiber integrates reply with fiber (fasthttp). Its aides respond
+// through a `*fiber.Ctx` using a reply.ResponseSink, while its error handler
+// converts `*fiber.Error` and handler errors using the replier's error
+// manifest.
+package replyfiber
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ooaklee/reply"
+)
+
+// sink sends responses to a fiber context
+type sink struct {
+	c *fiber.Ctx
+}
+
+// SetHeader replaces the values of the response header
+func (s *sink) SetHeader(key string, values []string) {
+	s.c.Response().Header.Del(key)
+	for _, value := range values {
+		s.c.Response().Header.Add(key, value)
+	}
+}
+
+// SetStatusCode sets the response's status code
+func (s *sink) SetStatusCode(statusCode int) {
+	s.c.Status(statusCode)
+}
+
+// Write appends the bytes to the response body
+func (s *sink) Write(b []byte) (int, error) {
+	return s.c.Write(b)
+}
+
+// NewSink returns a response sink sending responses to the fiber context
+func NewSink(c *fiber.Ctx) reply.ResponseSink {
+	return &sink{c: c}
+}
+
+// NewResponseWriter returns an http.ResponseWriter sending responses to the fiber
+// context, to be passed to any of the replier's aides
+func NewResponseWriter(c *fiber.Ctx) http.ResponseWriter {
+	return reply.NewSinkResponseWriter(NewSink(c))
+}
+
+// ErrorHandler returns a fiber error handler responding with the replier.
+//
+// Errors returned by handlers are resolved using the error manifest. A
+// `*fiber.Error` is resolved using the `http-<status code>` manifest key
+// (i.e. `http-413`), falling back to an item built from its status code and
+// message. The `not-found`, `method-not-allowed` and `unauthorized` keys are
+// used for the matching status codes, see reply.ErrNotFound.
+func ErrorHandler(replier *reply.Replier) fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		return Error(c, replier, convertError(err))
+	}
+}
+
+// MultiError sends a multi error response, see reply.Replier.NewHTTPMultiErrorResponse
+func MultiError(c *fiber.Ctx, replier *reply.Replier, errs []error, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPMultiErrorResponse(NewResponseWriter(c), errs, withRequest(c, attributes)...)
+}
+
+// Error sends an error response, see reply.Replier.NewHTTPErrorResponse
+func Error(c *fiber.Ctx, replier *reply.Replier, err error, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPErrorResponse(NewResponseWriter(c), err, withRequest(c, attributes)...)
+}
+
+// Data sends a data response, see reply.Replier.NewHTTPDataResponse
+func Data(c *fiber.Ctx, replier *reply.Replier, statusCode int, data interface{}, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPDataResponse(NewResponseWriter(c), statusCode, data, withRequest(c, attributes)...)
+}
+
+// Blank sends a blank response, see reply.Replier.NewHTTPBlankResponse
+func Blank(c *fiber.Ctx, replier *reply.Replier, statusCode int, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPBlankResponse(NewResponseWriter(c), statusCode, withRequest(c, attributes)...)
+}
+
+// Token sends a token response, see reply.Replier.NewHTTPTokenResponse
+func Token(c *fiber.Ctx, replier *reply.Replier, statusCode int, tokenOne, tokenTwo string, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPTokenResponse(NewResponseWriter(c), statusCode, tokenOne, tokenTwo, withRequest(c, attributes)...)
+}
+
+// withRequest returns the attributes preceded by the request's context and
+// method, so the passed attributes take precedence
+func withRequest(c *fiber.Ctx, attributes []reply.ResponseAttributes) []reply.ResponseAttributes {
+	return append([]reply.ResponseAttributes{
+		reply.WithContext(c.UserContext()),
+		reply.WithMethod(c.Method()),
+	}, attributes...)
+}
+
+// convertError returns the error resolved by the replier for the error returned
+// by a fiber handler
+func convertError(err error) error {
+
+	var fiberErr *fiber.Error
+	if !errors.As(err, &fiberErr) {
+		return err
+	}
+
+	switch fiberErr.Code {
+	case http.StatusNotFound:
+		return reply.ErrNotFound
+	case http.StatusMethodNotAllowed:
+		return reply.ErrMethodNotAllowed
+	case http.StatusUnauthorized:
+		return reply.ErrUnauthorized
+	}
+
+	item := reply.ErrorManifestItem{
+		Title:      http.StatusText(fiberErr.Code),
+		StatusCode: fiberErr.Code,
+	}
+
+	if fiberErr.Message != item.Title {
+		item.Detail = fiberErr.Message
+	}
+
+	return reply.NewErrorWithDefaultItem(fmt.Sprintf("http-%d", fiberErr.Code), item)
+}
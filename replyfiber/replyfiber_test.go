@@ -0,0 +1,159 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replyfiber_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replyfiber"
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestManifest returns the manifest used by tests
+func getTestManifest() []reply.ErrorManifest {
+	return []reply.ErrorManifest{
+		{
+			"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", Code: "U404", StatusCode: http.StatusNotFound},
+			"http-413":          reply.ErrorManifestItem{Title: "Payload Too Large", Code: "P413", StatusCode: http.StatusRequestEntityTooLarge},
+		},
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		path         string
+		handlerErr   error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success - Handler error resolved using manifest",
+			path:         "/",
+			handlerErr:   errors.New("example-404-error"),
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}`,
+		},
+		{
+			name:         "Success - Unmatched route",
+			path:         "/missing",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Not Found","status":"404"}]}`,
+		},
+		{
+			name:         "Success - Fiber error status resolved using manifest",
+			path:         "/",
+			handlerErr:   fiber.ErrRequestEntityTooLarge,
+			expectedCode: http.StatusRequestEntityTooLarge,
+			expectedBody: `{"errors":[{"title":"Payload Too Large","status":"413","code":"P413"}]}`,
+		},
+		{
+			name:         "Success - Fiber error without manifest entry",
+			path:         "/",
+			handlerErr:   fiber.NewError(http.StatusConflict, "user already exists"),
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"errors":[{"title":"Conflict","detail":"user already exists","status":"409"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{ErrorHandler: replyfiber.ErrorHandler(reply.NewReplier(getTestManifest()))})
+			app.Get("/", func(c *fiber.Ctx) error {
+				return test.handlerErr
+			})
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, test.path, nil))
+			assert.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, test.expectedCode, resp.StatusCode)
+			assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+			assert.Equal(t, test.expectedBody+"\n", string(body))
+		})
+	}
+}
+
+func TestAides(t *testing.T) {
+
+	replier := reply.NewReplier(getTestManifest())
+
+	tests := []struct {
+		name         string
+		handler      fiber.Handler
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Success - Data",
+			handler: func(c *fiber.Ctx) error {
+				return replyfiber.Data(c, replier, http.StatusOK, map[string]string{"id": "some-id"})
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"data":{"id":"some-id"}}`,
+		},
+		{
+			name: "Success - Error",
+			handler: func(c *fiber.Ctx) error {
+				return replyfiber.Error(c, replier, errors.New("example-404-error"))
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}`,
+		},
+		{
+			name: "Success - Multi error",
+			handler: func(c *fiber.Ctx) error {
+				return replyfiber.MultiError(c, replier, []error{errors.New("example-404-error")})
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404","code":"U404"}]}`,
+		},
+		{
+			name: "Success - Blank",
+			handler: func(c *fiber.Ctx) error {
+				return replyfiber.Blank(c, replier, http.StatusAccepted)
+			},
+			expectedCode: http.StatusAccepted,
+			expectedBody: `{"data":"{}"}`,
+		},
+		{
+			name: "Success - Token",
+			handler: func(c *fiber.Ctx) error {
+				return replyfiber.Token(c, replier, http.StatusOK, "access", "")
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"access_token":"access"}`,
+		},
+		{
+			name: "Success - Any aide using response writer",
+			handler: func(c *fiber.Ctx) error {
+				return replier.NewHTTPCreatedResponse(replyfiber.NewResponseWriter(c), "/users/some-id", map[string]string{"id": "some-id"})
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"data":{"id":"some-id"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", test.handler)
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, test.expectedCode, resp.StatusCode)
+			assert.Equal(t, test.expectedBody+"\n", string(body))
+		})
+	}
+}
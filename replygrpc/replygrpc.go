@@ -0,0 +1,202 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replygrpc maps gRPC status errors to reply error manifest items (and
+// back), so services exposing both gRPC and HTTP return consistent codes,
+// titles and details across protocols.
+package replygrpc
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ooaklee/reply"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// metadataDetailKey is the error info metadata key holding the manifest
+	// item's detail
+	metadataDetailKey = "detail"
+
+	// metadataAboutKey is the error info metadata key holding the manifest
+	// item's about link
+	metadataAboutKey = "about"
+)
+
+// ToGRPCStatus returns the gRPC status for the passed error, resolved using the
+// replier's error manifest
+func ToGRPCStatus(replier *reply.Replier, err error, attributes ...reply.ResponseAttributes) *status.Status {
+	return StatusFromErrorManifestItem(replier.ResolveErrorManifestItem(err, attributes...))
+}
+
+// StatusFromErrorManifestItem returns the gRPC status for the manifest item. The
+// status message holds the item's title, while its code, detail and about link
+// are added as an `ErrorInfo` detail.
+func StatusFromErrorManifestItem(item reply.ErrorManifestItem) *status.Status {
+
+	st := status.New(CodeFromHTTPStatus(item.StatusCode), item.Title)
+
+	metadata := map[string]string{}
+	if item.Detail != "" {
+		metadata[metadataDetailKey] = item.Detail
+	}
+	if item.About != "" {
+		metadata[metadataAboutKey] = item.About
+	}
+
+	if item.Code == "" && len(metadata) == 0 {
+		return st
+	}
+
+	detailedStatus, err := st.WithDetails(&errdetails.ErrorInfo{Reason: item.Code, Metadata: metadata})
+	if err != nil {
+		return st
+	}
+
+	return detailedStatus
+}
+
+// ErrorManifestItemFromStatus returns the manifest item for the gRPC status. Any
+// `ErrorInfo` detail is used to populate the item's code, detail and about link.
+func ErrorManifestItemFromStatus(st *status.Status) reply.ErrorManifestItem {
+
+	item := reply.ErrorManifestItem{
+		Title:      st.Message(),
+		StatusCode: HTTPStatusFromCode(st.Code()),
+	}
+
+	if item.Title == "" {
+		item.Title = http.StatusText(item.StatusCode)
+	}
+
+	for _, detail := range st.Details() {
+		errorInfo, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+
+		item.Code = errorInfo.GetReason()
+		item.Detail = errorInfo.GetMetadata()[metadataDetailKey]
+		item.About = errorInfo.GetMetadata()[metadataAboutKey]
+		break
+	}
+
+	return item
+}
+
+// statusError wraps a gRPC status error so it supplies its own manifest item
+type statusError struct {
+	err error
+	st  *status.Status
+}
+
+// Error returns the wrapped error's value
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// ErrorManifestItem returns the manifest item for the wrapped gRPC status
+func (e *statusError) ErrorManifestItem() reply.ErrorManifestItem {
+	return ErrorManifestItemFromStatus(e.st)
+}
+
+// WrapStatusError wraps the passed error, if it is a gRPC status error, so it
+// supplies its own manifest item (reply.ManifestedError) when passed to a
+// replier. Other errors are returned as is.
+func WrapStatusError(err error) error {
+	var grpcStatusErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcStatusErr) {
+		return err
+	}
+
+	st := grpcStatusErr.GRPCStatus()
+	if st.Code() == codes.OK {
+		return err
+	}
+
+	return &statusError{err: err, st: st}
+}
+
+// CodeFromHTTPStatus returns the gRPC code that best matches the HTTP status code
+func CodeFromHTTPStatus(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusOK:
+		return codes.OK
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusRequestedRangeNotSatisfiable:
+		return codes.OutOfRange
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case 499:
+		return codes.Canceled
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return codes.OK
+	case statusCode >= 400 && statusCode < 500:
+		return codes.FailedPrecondition
+	case statusCode >= 500 && statusCode < 600:
+		return codes.Internal
+	}
+
+	return codes.Unknown
+}
+
+// HTTPStatusFromCode returns the HTTP status code that best matches the gRPC code
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
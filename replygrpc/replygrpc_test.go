@@ -0,0 +1,108 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replygrpc_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replygrpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getTestManifest returns the manifest used by tests
+func getTestManifest() []reply.ErrorManifest {
+	return []reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", Detail: "The user could not be found", About: "www.example.com/errors/U404", Code: "U404", StatusCode: http.StatusNotFound}},
+	}
+}
+
+func TestToGRPCStatus(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+		expectedItem reply.ErrorManifestItem
+	}{
+		{
+			name:         "Success - Manifest error converted to status",
+			err:          errors.New("example-404-error"),
+			expectedCode: codes.NotFound,
+			expectedItem: getTestManifest()[0]["example-404-error"],
+		},
+		{
+			name:         "Success - Unmatched error converted to internal status",
+			err:          errors.New("example-missing-error"),
+			expectedCode: codes.Internal,
+			expectedItem: reply.ErrorManifestItem{Title: "Internal Server Error", StatusCode: http.StatusInternalServerError},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replier := reply.NewReplier(getTestManifest())
+
+			st := replygrpc.ToGRPCStatus(replier, test.err)
+
+			assert.Equal(t, test.expectedCode, st.Code())
+			assert.Equal(t, test.expectedItem, replygrpc.ErrorManifestItemFromStatus(st))
+		})
+	}
+}
+
+func TestWrapStatusError(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Status error uses its own manifest item",
+			err:                status.Error(codes.PermissionDenied, "Access Denied"),
+			expectedBody:       `{"errors":[{"title":"Access Denied","status":"403"}]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Success - Wrapped status error with error info",
+			err:                fmt.Errorf("upstream: %w", replygrpc.StatusFromErrorManifestItem(getTestManifest()[0]["example-404-error"]).Err()),
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","detail":"The user could not be found","about":"www.example.com/errors/U404","status":"404","code":"U404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Success - Non status error resolved using manifest",
+			err:                errors.New("example-404-error"),
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","detail":"The user could not be found","about":"www.example.com/errors/U404","status":"404","code":"U404"}]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getTestManifest())
+
+			err := replier.NewHTTPErrorResponse(w, replygrpc.WrapStatusError(test.err))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestCodeMapping(t *testing.T) {
+	for _, code := range []codes.Code{codes.OK, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied, codes.Unauthenticated, codes.ResourceExhausted, codes.Unimplemented, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled} {
+		assert.Equal(t, code, replygrpc.CodeFromHTTPStatus(replygrpc.HTTPStatusFromCode(code)), code.String())
+	}
+}
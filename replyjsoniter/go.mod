@@ -0,0 +1,19 @@
+module github.com/ooaklee/reply/replyjsoniter
+
+go 1.19
+
+require (
+	github.com/json-iterator/go v1.1.12
+	github.com/ooaklee/reply v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/modern-go/concurrent v0.0.0-20180228061459-e0a39a4cb421 // indirect
+	github.com/modern-go/reflect2 v1.0.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/ooaklee/reply => ..
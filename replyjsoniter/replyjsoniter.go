@@ -0,0 +1,57 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replyjsoniter provides a reply Encoder backed by json-iterator, for
+// latency-sensitive services that want to swap out the standard library's
+// JSON encoder.
+//
+//	replier := reply.NewReplier(manifests, reply.WithEncoder(replyjsoniter.New()))
+package replyjsoniter
+
+import (
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/ooaklee/reply"
+)
+
+// Encoder encodes transfer objects using a json-iterator configuration
+type Encoder struct {
+	api        jsoniter.API
+	escapeHTML *bool
+}
+
+// New returns an encoder using json-iterator's standard library compatible
+// configuration, so responses match those of the default encoder
+func New() *Encoder {
+	return NewWithConfig(jsoniter.ConfigCompatibleWithStandardLibrary)
+}
+
+// NewWithConfig returns an encoder using the passed json-iterator configuration,
+// i.e. `jsoniter.ConfigFastest`
+func NewWithConfig(api jsoniter.API) *Encoder {
+	return &Encoder{api: api}
+}
+
+// Encode writes the JSON encoding of v, followed by a newline, to w
+func (e *Encoder) Encode(w io.Writer, v interface{}) error {
+	encoder := e.api.NewEncoder(w)
+	if e.escapeHTML != nil {
+		encoder.SetEscapeHTML(*e.escapeHTML)
+	}
+
+	return encoder.Encode(v)
+}
+
+// SetEscapeHTML sets whether problematic HTML characters are escaped, overriding
+// the configuration's setting, see reply.WithJSONEscapeHTML
+func (e *Encoder) SetEscapeHTML(on bool) {
+	e.escapeHTML = &on
+}
+
+// ensure Encoder satisfies reply.Encoder and reply.EncoderEscapeHTML
+var (
+	_ reply.Encoder           = (*Encoder)(nil)
+	_ reply.EncoderEscapeHTML = (*Encoder)(nil)
+)
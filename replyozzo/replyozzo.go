@@ -0,0 +1,136 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replyozzo converts ozzo-validation errors into reply responses, so
+// services validating with ozzo return the same field error bodies as any
+// other reply service.
+//
+// ozzo's `validation.Errors` is a map of field to error, so the package does
+// not depend on ozzo itself; any error whose value is a `map[string]error`
+// (including nested ones, i.e. from validating embedded structs or slices with
+// `validation.Each`) is converted.
+package replyozzo
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/ooaklee/reply"
+)
+
+// errorType is the reflected type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ValidationError sends a validation (422) error response holding the field
+// errors of the passed ozzo error, see reply.Replier.NewHTTPValidationErrorResponse.
+//
+// NOTE - Errors that are not ozzo field errors, i.e. a `validation.InternalError`,
+// are sent as an error response resolved with the replier's error manifest
+func ValidationError(w http.ResponseWriter, replier *reply.Replier, err error, attributes ...reply.ResponseAttributes) error {
+	fieldErrors, ok := FieldErrors(err)
+	if !ok {
+		return replier.NewHTTPErrorResponse(w, err, attributes...)
+	}
+
+	return replier.NewHTTPValidationErrorResponse(w, fieldErrors, attributes...)
+}
+
+// MultiError sends a multi error response holding the field errors of the
+// passed ozzo error, each resolved with the replier's error manifest, see
+// reply.Replier.NewHTTPMultiErrorResponse.
+//
+// NOTE - Errors that are not ozzo field errors are sent as the only error of
+// the response
+func MultiError(w http.ResponseWriter, replier *reply.Replier, err error, attributes ...reply.ResponseAttributes) error {
+	return replier.NewHTTPMultiErrorResponse(w, Errors(err), attributes...)
+}
+
+// FieldErrors returns the messages of the passed ozzo error keyed on the JSON
+// pointer of their field (i.e. `/address/city`), and whether it holds field
+// errors. The result can be passed to reply.Replier.NewHTTPValidationErrorResponse.
+func FieldErrors(err error) (map[string][]string, bool) {
+	fields, ok := getFieldErrors(err)
+	if !ok {
+		return nil, false
+	}
+
+	fieldErrors := make(map[string][]string, len(fields))
+	for pointer, fieldErr := range fields {
+		fieldErrors[pointer] = append(fieldErrors[pointer], fieldErr.Error())
+	}
+
+	return fieldErrors, true
+}
+
+// Errors returns the field errors of the passed ozzo error, ordered by field,
+// with each wrapped with the JSON pointer of its field, see reply.ErrorWithSource.
+// Errors that are not ozzo field errors are returned on their own.
+func Errors(err error) []error {
+	fields, ok := getFieldErrors(err)
+	if !ok {
+		return []error{err}
+	}
+
+	pointers := make([]string, 0, len(fields))
+	for pointer := range fields {
+		pointers = append(pointers, pointer)
+	}
+	sort.Strings(pointers)
+
+	errs := make([]error, 0, len(pointers))
+	for _, pointer := range pointers {
+		errs = append(errs, reply.ErrorWithSource(fields[pointer], pointer, ""))
+	}
+
+	return errs
+}
+
+// getFieldErrors returns the field errors of the first error in the chain that
+// is a map of field to error, keyed on the JSON pointer of their field
+func getFieldErrors(err error) (map[string]error, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if value, ok := getErrorsMap(err); ok {
+			fields := make(map[string]error)
+			flattenFieldErrors(fields, "", value)
+
+			return fields, true
+		}
+	}
+
+	return nil, false
+}
+
+// getErrorsMap returns the reflected value of the error if it is a map of field
+// to error, i.e. ozzo's `validation.Errors`
+func getErrorsMap(err error) (reflect.Value, bool) {
+	value := reflect.ValueOf(err)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String || value.Type().Elem() != errorType {
+		return value, false
+	}
+
+	return value, true
+}
+
+// flattenFieldErrors adds the errors of the map to the fields, keyed on the JSON
+// pointer of their field, descending into nested maps of field to error
+func flattenFieldErrors(fields map[string]error, prefix string, value reflect.Value) {
+	iter := value.MapRange()
+	for iter.Next() {
+		fieldErr, _ := iter.Value().Interface().(error)
+		if fieldErr == nil {
+			continue
+		}
+
+		pointer := prefix + "/" + iter.Key().String()
+
+		if nested, ok := getErrorsMap(fieldErr); ok {
+			flattenFieldErrors(fields, pointer, nested)
+			continue
+		}
+
+		fields[pointer] = fieldErr
+	}
+}
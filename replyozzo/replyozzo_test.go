@@ -0,0 +1,137 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replyozzo_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replyozzo"
+	"github.com/stretchr/testify/assert"
+)
+
+// ozzoErrors mirrors ozzo-validation's `validation.Errors`
+type ozzoErrors map[string]error
+
+// Error returns the errors' messages
+func (e ozzoErrors) Error() string {
+	return fmt.Sprintf("%d validation errors", len(e))
+}
+
+// getTestManifest returns the manifest used by tests
+func getTestManifest() []reply.ErrorManifest {
+	return []reply.ErrorManifest{
+		{"must be a valid email address": reply.ErrorManifestItem{Title: "Invalid Email", Code: "V001", StatusCode: http.StatusBadRequest}},
+		{"cannot be blank": reply.ErrorManifestItem{Title: "Missing Field", Code: "V002", StatusCode: http.StatusBadRequest}},
+	}
+}
+
+// getTestErrors returns nested ozzo errors
+func getTestErrors() error {
+	return ozzoErrors{
+		"name":  errors.New("cannot be blank"),
+		"email": errors.New("must be a valid email address"),
+		"age":   nil,
+		"address": ozzoErrors{
+			"city": errors.New("the length must be between 2 and 50"),
+		},
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedFields map[string][]string
+		expectedOk     bool
+	}{
+		{
+			name: "Success - Nested errors keyed on their pointer",
+			err:  getTestErrors(),
+			expectedFields: map[string][]string{
+				"/name":         {"cannot be blank"},
+				"/email":        {"must be a valid email address"},
+				"/address/city": {"the length must be between 2 and 50"},
+			},
+			expectedOk: true,
+		},
+		{
+			name:           "Success - Wrapped errors converted",
+			err:            fmt.Errorf("failed to validate user: %w", ozzoErrors{"name": errors.New("cannot be blank")}),
+			expectedFields: map[string][]string{"/name": {"cannot be blank"}},
+			expectedOk:     true,
+		},
+		{
+			name:       "Failure - Internal error not converted",
+			err:        errors.New("validation rule failed"),
+			expectedOk: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields, ok := replyozzo.FieldErrors(test.err)
+
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedFields, fields)
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Field errors sent in stable order",
+			err:                getTestErrors(),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"the length must be between 2 and 50","status":"422","source":{"pointer":"/address/city"}},{"title":"Invalid Email","status":"400","code":"V001","source":{"pointer":"/email"}},{"title":"Missing Field","status":"400","code":"V002","source":{"pointer":"/name"}}]}`,
+		},
+		{
+			name:               "Success - Internal error resolved with manifest",
+			err:                errors.New("validation rule failed"),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       `{"errors":[{"title":"Internal Server Error","status":"500"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getTestManifest())
+
+			err := replyozzo.ValidationError(w, replier, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestMultiError(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier(getTestManifest())
+
+	err := replyozzo.MultiError(w, replier, ozzoErrors{
+		"name":  errors.New("cannot be blank"),
+		"email": errors.New("must be a valid email address"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, `{"errors":[{"title":"Invalid Email","status":"400","code":"V001","source":{"pointer":"/email"}},{"title":"Missing Field","status":"400","code":"V002","source":{"pointer":"/name"}}]}`+"\n", w.Body.String())
+}
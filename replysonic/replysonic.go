@@ -0,0 +1,60 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replysonic provides a reply Encoder backed by bytedance/sonic, for
+// latency-sensitive services that want to swap out the standard library's
+// JSON encoder.
+//
+//	replier := reply.NewReplier(manifests, reply.WithEncoder(replysonic.New()))
+//
+// NOTE - On platforms sonic does not support, it falls back to the standard
+// library's JSON encoder
+package replysonic
+
+import (
+	"io"
+
+	"github.com/bytedance/sonic"
+	"github.com/ooaklee/reply"
+)
+
+// Encoder encodes transfer objects using a sonic configuration
+type Encoder struct {
+	api        sonic.API
+	escapeHTML *bool
+}
+
+// New returns an encoder using sonic's standard library compatible
+// configuration, so responses match those of the default encoder
+func New() *Encoder {
+	return NewWithConfig(sonic.ConfigStd)
+}
+
+// NewWithConfig returns an encoder using the passed sonic configuration,
+// i.e. `sonic.ConfigFastest`
+func NewWithConfig(api sonic.API) *Encoder {
+	return &Encoder{api: api}
+}
+
+// Encode writes the JSON encoding of v, followed by a newline, to w
+func (e *Encoder) Encode(w io.Writer, v interface{}) error {
+	encoder := e.api.NewEncoder(w)
+	if e.escapeHTML != nil {
+		encoder.SetEscapeHTML(*e.escapeHTML)
+	}
+
+	return encoder.Encode(v)
+}
+
+// SetEscapeHTML sets whether problematic HTML characters are escaped, overriding
+// the configuration's setting, see reply.WithJSONEscapeHTML
+func (e *Encoder) SetEscapeHTML(on bool) {
+	e.escapeHTML = &on
+}
+
+// ensure Encoder satisfies reply.Encoder and reply.EncoderEscapeHTML
+var (
+	_ reply.Encoder           = (*Encoder)(nil)
+	_ reply.EncoderEscapeHTML = (*Encoder)(nil)
+)
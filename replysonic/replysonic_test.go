@@ -0,0 +1,68 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replysonic_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replysonic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", StatusCode: http.StatusNotFound}},
+	}
+
+	tests := []struct {
+		name               string
+		send               func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedStatusCode int
+	}{
+		{
+			name: "Success - Data response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, map[string]interface{}{"id": "some-id", "name": "john doe"}, reply.WithMeta(map[string]interface{}{"example": "meta"}))
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Success - Error response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := httptest.NewRecorder()
+			actual := httptest.NewRecorder()
+
+			assert.NoError(t, test.send(reply.NewReplier(manifests), expected))
+			assert.NoError(t, test.send(reply.NewReplier(manifests, reply.WithEncoder(replysonic.New())), actual))
+
+			assert.Equal(t, test.expectedStatusCode, actual.Code)
+			assert.Equal(t, expected.Body.String(), actual.Body.String())
+		})
+	}
+}
+
+func TestEncoder_SetEscapeHTML(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	replier := reply.NewReplier(nil, reply.WithEncoder(replysonic.New()), reply.WithJSONEscapeHTML(false))
+
+	err := replier.NewHTTPDataResponse(w, http.StatusOK, "<b>a & b</b>")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"data\":\"<b>a & b</b>\"}\n", w.Body.String())
+}
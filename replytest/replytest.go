@@ -0,0 +1,107 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+// Package replytest provides utilities for testing responses sent by reply,
+// such as comparing response bodies against golden files.
+package replytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ooaklee/reply"
+)
+
+// updateFlagName is the name of the flag used to update golden files, i.e.
+// `go test ./... -update`
+const updateFlagName = "update"
+
+func init() {
+	if flag.Lookup(updateFlagName) == nil {
+		flag.Bool(updateFlagName, false, "update golden files")
+	}
+}
+
+// Golden compares the canonical form of the recorded response body against the
+// golden file `testdata/<name>.golden`, failing the test if they differ. When
+// tests are run with the `-update` flag, the golden file is written instead.
+//
+// JSON bodies are canonicalised by sorting object keys and indenting, so golden
+// files are stable and readable. Other bodies are compared as is.
+//
+// NOTE - The `-update` flag is registered by this package, unless a flag with
+// the same name is registered first
+func Golden(t testing.TB, name string, rec *httptest.ResponseRecorder) {
+	t.Helper()
+
+	actual := Canonical(rec.Body.Bytes())
+	path := filepath.Join("testdata", name+".golden")
+
+	if shouldUpdate() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("replytest: failed to create golden file directory with %v", err)
+		}
+
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatalf("replytest: failed to write golden file %s with %v", path, err)
+		}
+
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("replytest: failed to read golden file %s with %v, run with -%s to create it", path, err, updateFlagName)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("replytest: response body does not match golden file %s\n\nexpected:\n%s\nactual:\n%s", path, expected, actual)
+	}
+}
+
+// Canonical returns the canonical form of the body. JSON bodies have their
+// object keys sorted and are indented, other bodies are returned as is.
+func Canonical(body []byte) []byte {
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return body
+	}
+
+	canonical, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return body
+	}
+
+	return append(canonical, '\n')
+}
+
+// shouldUpdate returns whether golden files should be written
+func shouldUpdate() bool {
+	updateFlag := flag.Lookup(updateFlagName)
+
+	return updateFlag != nil && updateFlag.Value.String() == "true"
+}
+
+// DecodeEnvelope decodes the recorded response body rendered by the default
+// transfer object, decoding its data into T, failing the test if the body can
+// not be decoded
+func DecodeEnvelope[T any](t testing.TB, rec *httptest.ResponseRecorder) reply.Envelope[T] {
+	t.Helper()
+
+	envelope, err := reply.DecodeDefaultEnvelope[T](bytes.NewReader(rec.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("replytest: failed to decode response body with %v", err)
+	}
+
+	return envelope
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package replytest_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/ooaklee/reply/replytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGolden(t *testing.T) {
+
+	replier := reply.NewReplier([]reply.ErrorManifest{
+		{"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", Code: "U404", StatusCode: http.StatusNotFound}},
+	})
+
+	tests := []struct {
+		name string
+		send func(w http.ResponseWriter) error
+	}{
+		{
+			name: "data_response",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, map[string]interface{}{"name": "john doe", "id": "some-id"}, reply.WithMeta(map[string]interface{}{"region": "eu"}))
+			},
+		},
+		{
+			name: "error_response",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+
+			assert.NoError(t, test.send(rec))
+
+			replytest.Golden(t, test.name, rec)
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "Success - Keys sorted and indented",
+			body:     `{"meta":{"b":1,"a":2.50},"data":"{}"}`,
+			expected: "{\n  \"data\": \"{}\",\n  \"meta\": {\n    \"a\": 2.50,\n    \"b\": 1\n  }\n}\n",
+		},
+		{
+			name:     "Success - Non JSON body returned as is",
+			body:     "id,name\n1,john\n",
+			expected: "id,name\n1,john\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, string(replytest.Canonical([]byte(test.body))))
+		})
+	}
+}
+
+func TestDecodeEnvelope(t *testing.T) {
+
+	type user struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	rec := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	assert.NoError(t, reply.Data(replier, rec, http.StatusOK, user{ID: "some-id", Name: "john doe"}, reply.WithMeta(map[string]interface{}{"region": "eu"})))
+
+	envelope := replytest.DecodeEnvelope[user](t, rec)
+
+	assert.Equal(t, user{ID: "some-id", Name: "john doe"}, envelope.Data)
+	assert.Equal(t, map[string]interface{}{"region": "eu"}, envelope.Meta)
+}
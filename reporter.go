@@ -0,0 +1,81 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"context"
+)
+
+// ErrorReport holds the details of an error passed to a reporter
+type ErrorReport struct {
+
+	// Context the context of the response request, `context.Background()` if
+	// one was not provided
+	Context context.Context
+
+	// Error the original error passed to the replier
+	Error error
+
+	// ManifestItem the manifest item the error was resolved to
+	ManifestItem ErrorManifestItem
+
+	// StatusCode the status code of the resolved manifest item
+	StatusCode int
+
+	// Matched whether the error had a corresponding manifest entry. If false,
+	// the manifest item is the fallback error manifest item.
+	Matched bool
+}
+
+// Reporter outlines expected methods of an error reporter, used to capture
+// errors with external services, i.e. Sentry or Bugsnag
+type Reporter interface {
+	Report(report ErrorReport)
+}
+
+// ReporterFunc is an adapter to allow the use of ordinary functions as reporters
+type ReporterFunc func(report ErrorReport)
+
+// Report calls f(report)
+func (f ReporterFunc) Report(report ErrorReport) {
+	f(report)
+}
+
+// WithErrorReporter sets the reporter that receives every error that resolves to
+// a 5xx manifest item, or does not have a corresponding manifest entry
+//
+// NOTE - Manifest items with a Severity are reported only when their severity
+// is SeverityError or SeverityCritical
+func WithErrorReporter(reporter Reporter) Option {
+	return func(r *Replier) {
+		r.reporter = reporter
+	}
+}
+
+// reportError passes the error to the replier's reporter, if the error's severity
+// is reportable, or, when the severity is unset, if the error resolved to a 5xx
+// manifest item or did not have a corresponding manifest entry
+func (r *Replier) reportError(err error, item ErrorManifestItem, matched bool, response *NewResponseRequest) {
+
+	if r.reporter == nil {
+		return
+	}
+
+	if item.Severity != "" && !item.Severity.isReportable() {
+		return
+	}
+
+	if item.Severity == "" && matched && !is5xx(item.StatusCode) {
+		return
+	}
+
+	r.reporter.Report(ErrorReport{
+		Context:      getResponseContext(response),
+		Error:        err,
+		ManifestItem: item,
+		StatusCode:   item.StatusCode,
+		Matched:      matched,
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+type reporterContextKey struct{}
+
+func TestReplier_WithErrorReporter(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-upstream-error": reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway},
+	})
+
+	tests := []struct {
+		name            string
+		errs            []error
+		expectedReports []reply.ErrorReport
+	}{
+		{
+			name: "Success - Client errors not reported",
+			errs: getMultiErrors(),
+		},
+		{
+			name: "Success - Matched 5xx error reported",
+			errs: []error{errors.New("example-upstream-error")},
+			expectedReports: []reply.ErrorReport{
+				{Error: errors.New("example-upstream-error"), ManifestItem: reply.ErrorManifestItem{Title: "Bad Gateway", StatusCode: http.StatusBadGateway}, StatusCode: http.StatusBadGateway, Matched: true},
+			},
+		},
+		{
+			name: "Success - Unmatched error reported",
+			errs: []error{getExampleErrorOne(), errors.New("example-missing-error")},
+			expectedReports: []reply.ErrorReport{
+				{Error: errors.New("example-missing-error"), ManifestItem: reply.ErrorManifestItem{Title: "Internal Server Error", StatusCode: http.StatusInternalServerError}, StatusCode: http.StatusInternalServerError},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx := context.WithValue(context.Background(), reporterContextKey{}, "request-value")
+
+			reports := []reply.ErrorReport{}
+			replier := reply.NewReplier(manifests, reply.WithErrorReporter(reply.ReporterFunc(func(report reply.ErrorReport) {
+				assert.Equal(t, "request-value", report.Context.Value(reporterContextKey{}))
+				report.Context = nil
+				reports = append(reports, report)
+			})))
+
+			err := replier.NewHTTPMultiErrorResponse(w, test.errs, reply.WithContext(ctx))
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(test.expectedReports), len(reports))
+			for i, expected := range test.expectedReports {
+				assert.Equal(t, expected, reports[i])
+			}
+		})
+	}
+}
+
+func TestReplier_WithErrorReporterUnsentErrors(t *testing.T) {
+
+	reports := []reply.ErrorReport{}
+	replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithErrorReporter(reply.ReporterFunc(func(report reply.ErrorReport) {
+		reports = append(reports, report)
+	})))
+
+	item := replier.ResolveErrorManifestItem(errors.New("example-missing-error"))
+	assert.Equal(t, http.StatusInternalServerError, item.StatusCode)
+
+	w := httptest.NewRecorder()
+	err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithWarnings([]error{errors.New("example-missing-error")}))
+	assert.NoError(t, err)
+
+	assert.Empty(t, reports)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// defaultPropagatedHeaders are the request headers copied onto responses by
+// default, see WithRequest
+var defaultPropagatedHeaders = []string{"X-Request-ID", "traceparent"}
+
+// WithPropagatedHeaders replaces the request headers copied onto responses
+// generated with WithRequest, by default `X-Request-ID` and `traceparent`.
+// Passing no headers disables propagation.
+func WithPropagatedHeaders(headers ...string) Option {
+	return func(r *Replier) {
+		r.propagatedHeaders = headers
+	}
+}
+
+// WithRequest adds the incoming request to the response request, making it
+// available to hooks. The request's context, method, `Accept`,
+// `Accept-Language`, `Origin` and `If-Modified-Since` headers are used for the
+// response unless already set by earlier attributes, and the replier's
+// propagated headers (i.e. `X-Request-ID`) are copied onto the response.
+func WithRequest(request *http.Request) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		if request == nil {
+			return
+		}
+
+		r.Request = request
+
+		if r.Context == nil {
+			r.Context = request.Context()
+		}
+
+		if r.Method == "" {
+			r.Method = request.Method
+		}
+
+		if r.Accept == "" {
+			r.Accept = request.Header.Get("Accept")
+		}
+
+		if r.AcceptLanguage == "" {
+			r.AcceptLanguage = request.Header.Get("Accept-Language")
+		}
+
+		if r.Origin == "" {
+			r.Origin = request.Header.Get("Origin")
+		}
+
+		if r.IfModifiedSince == "" {
+			r.IfModifiedSince = request.Header.Get("If-Modified-Since")
+		}
+	}
+}
+
+// setPropagatedHeaders copies the replier's propagated headers from the response
+// request's incoming request, unless the header is already set
+func (r *Replier) setPropagatedHeaders(response *NewResponseRequest) {
+	if response.Request == nil {
+		return
+	}
+
+	header := response.transferObject.GetWriter().Header()
+	for _, name := range r.propagatedHeaders {
+		value := response.Request.Header.Get(name)
+		if value == "" || header.Get(name) != "" {
+			continue
+		}
+
+		header.Set(name, value)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithRequest(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		options         []reply.Option
+		attributes      func(request *http.Request) []reply.ResponseAttributes
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{
+			name: "Success - Default headers propagated",
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				return []reply.ResponseAttributes{reply.WithRequest(request)}
+			},
+			expectedHeaders: map[string]string{
+				"X-Request-ID": "req-123",
+				"traceparent":  "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+				"X-Tenant":     "",
+			},
+			expectedBody: `{"data":{"name":"john doe"}}`,
+		},
+		{
+			name:    "Success - Configured headers propagated",
+			options: []reply.Option{reply.WithPropagatedHeaders("X-Tenant")},
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				return []reply.ResponseAttributes{reply.WithRequest(request)}
+			},
+			expectedHeaders: map[string]string{
+				"X-Request-ID": "",
+				"X-Tenant":     "acme",
+			},
+			expectedBody: `{"data":{"name":"john doe"}}`,
+		},
+		{
+			name: "Success - Response headers not overridden",
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				return []reply.ResponseAttributes{
+					reply.WithRequest(request),
+					reply.WithHeaders(map[string]string{"X-Request-ID": "res-456"}),
+				}
+			},
+			expectedHeaders: map[string]string{
+				"X-Request-ID": "res-456",
+			},
+			expectedBody: `{"data":{"name":"john doe"}}`,
+		},
+		{
+			name: "Success - Request method used",
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				request.Method = http.MethodHead
+				return []reply.ResponseAttributes{reply.WithRequest(request)}
+			},
+			expectedHeaders: map[string]string{
+				"X-Request-ID": "req-123",
+			},
+		},
+		{
+			name: "Success - Earlier attributes kept",
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				request.Method = http.MethodHead
+				return []reply.ResponseAttributes{reply.WithMethod(http.MethodGet), reply.WithRequest(request)}
+			},
+			expectedBody: `{"data":{"name":"john doe"}}`,
+		},
+		{
+			name: "Success - Nil request ignored",
+			attributes: func(request *http.Request) []reply.ResponseAttributes {
+				return []reply.ResponseAttributes{reply.WithRequest(nil)}
+			},
+			expectedHeaders: map[string]string{
+				"X-Request-ID": "",
+			},
+			expectedBody: `{"data":{"name":"john doe"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			request.Header.Set("X-Request-ID", "req-123")
+			request.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+			request.Header.Set("X-Tenant", "acme")
+
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{}, test.options...)
+
+			err := replier.NewHTTPDataResponse(w, http.StatusOK, map[string]string{"name": "john doe"}, test.attributes(request)...)
+
+			assert.NoError(t, err)
+			for name, value := range test.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(name), name)
+			}
+			if test.expectedBody != "" {
+				assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+			} else {
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestReplier_WithRequestAvailableToHooks(t *testing.T) {
+	var hookRequest *http.Request
+
+	replier := reply.NewReplier([]reply.ErrorManifest{}, reply.WithPostSendHooks(func(response *reply.NewResponseRequest, result reply.ResponseResult) {
+		hookRequest = response.Request
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+
+	err := replier.NewHTTPBlankResponse(httptest.NewRecorder(), http.StatusOK, reply.WithRequest(request))
+
+	assert.NoError(t, err)
+	assert.Equal(t, request, hookRequest)
+}
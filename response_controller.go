@@ -0,0 +1,23 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+//go:build go1.20
+
+package reply
+
+import (
+	"net/http"
+	"time"
+)
+
+// setWriteDeadline sets the writer's write deadline using `http.ResponseController`
+func setWriteDeadline(writer http.ResponseWriter, deadline time.Time) error {
+	return http.NewResponseController(writer).SetWriteDeadline(deadline)
+}
+
+// flushWriter flushes the writer using `http.ResponseController`, writers that
+// can not be flushed are ignored
+func flushWriter(writer http.ResponseWriter) {
+	_ = http.NewResponseController(writer).Flush()
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+//go:build !go1.20
+
+package reply
+
+import (
+	"net/http"
+	"time"
+)
+
+// setWriteDeadline sets the write deadline of the writer, or a writer it wraps
+func setWriteDeadline(writer http.ResponseWriter, deadline time.Time) error {
+	for {
+		switch w := writer.(type) {
+		case interface{ SetWriteDeadline(time.Time) error }:
+			return w.SetWriteDeadline(deadline)
+		case interface{ Unwrap() http.ResponseWriter }:
+			writer = w.Unwrap()
+		default:
+			return http.ErrNotSupported
+		}
+	}
+}
+
+// flushWriter flushes the writer, or a writer it wraps, if it implements
+// `http.Flusher`
+func flushWriter(writer http.ResponseWriter) {
+	for {
+		switch w := writer.(type) {
+		case http.Flusher:
+			w.Flush()
+			return
+		case interface{ Unwrap() http.ResponseWriter }:
+			writer = w.Unwrap()
+		default:
+			return
+		}
+	}
+}
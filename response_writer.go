@@ -0,0 +1,44 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// bufferedResponseWriter is an in-memory http.ResponseWriter used to capture
+// responses generated by the replier without sending them
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+// newBufferedResponseWriter returns an empty buffered response writer
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{header: http.Header{}}
+}
+
+// Header returns the response headers
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write adds the bytes to the buffered body
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	return w.body.Write(b)
+}
+
+// WriteHeader records the response's status code
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// ResponseResult holds the outcome of a sent response, so callers can log and
+// meter responses without wrapping the response writer
+type ResponseResult struct {
+	// StatusCode is the status code written to the client
+	StatusCode int
+
+	// BytesWritten is the size of the response body
+	BytesWritten int
+
+	// ContentType is the response's `Content-Type` header value
+	ContentType string
+
+	// ErrorCodes holds the codes of the manifest items the response's
+	// error(s) resolved to, items without a code are omitted
+	ErrorCodes []string
+}
+
+// NewHTTPResponseWithResult works like NewHTTPResponse, returning the result
+// of the sent response
+func (r *Replier) NewHTTPResponseWithResult(response *NewResponseRequest) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	response.result = result
+
+	err := r.NewHTTPResponse(response)
+
+	return result, err
+}
+
+// NewHTTPMultiErrorResponseWithResult this response aide works like
+// NewHTTPMultiErrorResponse, returning the result of the sent response
+func (r *Replier) NewHTTPMultiErrorResponseWithResult(w http.ResponseWriter, errs []error, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	err := r.NewHTTPMultiErrorResponse(w, errs, withResultAttribute(attributes, result)...)
+
+	return result, err
+}
+
+// NewHTTPErrorResponseWithResult this response aide works like
+// NewHTTPErrorResponse, returning the result of the sent response
+func (r *Replier) NewHTTPErrorResponseWithResult(w http.ResponseWriter, err error, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	sendErr := r.NewHTTPErrorResponse(w, err, withResultAttribute(attributes, result)...)
+
+	return result, sendErr
+}
+
+// NewHTTPDataResponseWithResult this response aide works like
+// NewHTTPDataResponse, returning the result of the sent response
+func (r *Replier) NewHTTPDataResponseWithResult(w http.ResponseWriter, statusCode int, data interface{}, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	err := r.NewHTTPDataResponse(w, statusCode, data, withResultAttribute(attributes, result)...)
+
+	return result, err
+}
+
+// NewHTTPBlankResponseWithResult this response aide works like
+// NewHTTPBlankResponse, returning the result of the sent response
+func (r *Replier) NewHTTPBlankResponseWithResult(w http.ResponseWriter, statusCode int, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	err := r.NewHTTPBlankResponse(w, statusCode, withResultAttribute(attributes, result)...)
+
+	return result, err
+}
+
+// NewHTTPTokenResponseWithResult this response aide works like
+// NewHTTPTokenResponse, returning the result of the sent response
+func (r *Replier) NewHTTPTokenResponseWithResult(w http.ResponseWriter, statusCode int, tokenOne, tokenTwo string, attributes ...ResponseAttributes) (*ResponseResult, error) {
+	result := &ResponseResult{}
+	err := r.NewHTTPTokenResponse(w, statusCode, tokenOne, tokenTwo, withResultAttribute(attributes, result)...)
+
+	return result, err
+}
+
+// withResult sets the result populated once the response is sent
+func withResult(result *ResponseResult) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.result = result
+	}
+}
+
+// withResultAttribute returns the passed attributes with the result attribute
+// appended.
+//
+// NOTE - The attributes are copied, so the caller's slice is never written to
+// when it has spare capacity
+func withResultAttribute(attributes []ResponseAttributes, result *ResponseResult) []ResponseAttributes {
+	return append(attributes[:len(attributes):len(attributes)], withResult(result))
+}
+
+// recordResponseResult wraps the response's writer so the result of the
+// response can be recorded, returning a function that populates the result.
+func recordResponseResult(response *NewResponseRequest) func() {
+	writer := NewResponseWriterRecorder(response.Writer)
+	response.Writer = writer
+
+	return func() {
+		response.Writer = writer.ResponseWriter
+
+		response.result.StatusCode = writer.statusCode
+		response.result.BytesWritten = writer.bytesWritten
+		response.result.ContentType = writer.Header().Get("Content-Type")
+	}
+}
+
+// setErrorCodes records the codes of the response's transfer object errors,
+// errors without a code are skipped
+func (result *ResponseResult) setErrorCodes(transferObjectErrors []TransferObjectError) {
+	if result == nil {
+		return
+	}
+
+	for _, transferObjectError := range transferObjectErrors {
+		if code := transferObjectError.GetCode(); !isEmpty(code) {
+			result.ErrorCodes = append(result.ErrorCodes, code)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ResponseResult(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	tests := []struct {
+		name           string
+		send           func(w http.ResponseWriter) (*reply.ResponseResult, error)
+		expectedResult reply.ResponseResult
+	}{
+		{
+			name: "Success - Data response",
+			send: func(w http.ResponseWriter) (*reply.ResponseResult, error) {
+				return replier.NewHTTPDataResponseWithResult(w, http.StatusCreated, getTestUser())
+			},
+			expectedResult: reply.ResponseResult{
+				StatusCode:   http.StatusCreated,
+				BytesWritten: len(stringWithNewLine(getDataResponseBody())),
+				ContentType:  "application/json",
+			},
+		},
+		{
+			name: "Success - Multi error response",
+			send: func(w http.ResponseWriter) (*reply.ResponseResult, error) {
+				return replier.NewHTTPMultiErrorResponseWithResult(w, getMultiErrors())
+			},
+			expectedResult: reply.ResponseResult{
+				StatusCode:   http.StatusBadRequest,
+				BytesWritten: len(stringWithNewLine(getMultiErrorResponseMultiErrors())),
+				ContentType:  "application/json",
+				ErrorCodes:   []string{"100YT", "1011"},
+			},
+		},
+		{
+			name: "Success - Response request",
+			send: func(w http.ResponseWriter) (*reply.ResponseResult, error) {
+				return replier.NewHTTPResponseWithResult(&reply.NewResponseRequest{Writer: w, Error: getExampleErrorOne()})
+			},
+			expectedResult: reply.ResponseResult{
+				StatusCode:   http.StatusNotFound,
+				BytesWritten: len(stringWithNewLine(getErrorResponseForExampleErrorOne())),
+				ContentType:  "application/json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			result, err := test.send(w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResult, *result)
+			assert.Equal(t, test.expectedResult.BytesWritten, w.Body.Len())
+		})
+	}
+}
+
+func TestReplier_ResponseResultAttributes(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	attributes := make([]reply.ResponseAttributes, 1, 2)
+	attributes[0] = reply.WithHeaders(map[string]string{"X-Request-ID": "123"})
+
+	w := httptest.NewRecorder()
+	_, err := replier.NewHTTPDataResponseWithResult(w, http.StatusOK, getTestUser(), attributes...)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "123", w.Header().Get("X-Request-ID"))
+	assert.Nil(t, attributes[:cap(attributes)][1])
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+	"strings"
+)
+
+// WithNotFoundManifestKey sets the manifest key used by NotFoundHandler, in place
+// of `not-found`
+func WithNotFoundManifestKey(key string) Option {
+	return func(r *Replier) {
+		r.notFoundManifestKey = key
+	}
+}
+
+// WithMethodNotAllowedManifestKey sets the manifest key used by
+// MethodNotAllowedHandler, in place of `method-not-allowed`
+func WithMethodNotAllowedManifestKey(key string) Option {
+	return func(r *Replier) {
+		r.methodNotAllowedManifestKey = key
+	}
+}
+
+// NotFoundHandler returns a handler responding with the manifest item for
+// unmatched routes, to be set as a router's not found handler (i.e.
+// gorilla/mux's `Router.NotFoundHandler`)
+//
+// NOTE - The item is resolved using the `not-found` manifest key, see
+// WithNotFoundManifestKey and ErrNotFound
+func (r *Replier) NotFoundHandler() http.Handler {
+	err := getRouteError(ErrNotFound, r.notFoundManifestKey)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_ = r.NewHTTPErrorResponse(w, err, WithContext(req.Context()), WithMethod(req.Method))
+	})
+}
+
+// MethodNotAllowedHandler returns a handler responding with the manifest item
+// for routes not supporting the request's method, to be set as a router's
+// method not allowed handler (i.e. gorilla/mux's `Router.MethodNotAllowedHandler`).
+// The `Allow` header lists the passed allowed methods, if any.
+//
+// NOTE - The item is resolved using the `method-not-allowed` manifest key, see
+// WithMethodNotAllowedManifestKey and ErrMethodNotAllowed
+func (r *Replier) MethodNotAllowedHandler(allowed ...string) http.Handler {
+	err := getRouteError(ErrMethodNotAllowed, r.methodNotAllowedManifestKey)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		request := NewResponseRequest{
+			Writer:  w,
+			Error:   err,
+			Context: req.Context(),
+			Method:  req.Method,
+		}
+
+		if len(allowed) > 0 {
+			setResponseHeader(&request, "Allow", strings.Join(allowed, ", "))
+		}
+
+		_ = r.NewHTTPResponse(&request)
+	})
+}
+
+// getRouteError returns the default error, resolved using the passed manifest
+// key if set
+func getRouteError(defaultErr error, key string) error {
+	if isEmpty(key) {
+		return defaultErr
+	}
+
+	return NewErrorWithDefaultItem(key, defaultErr.(*defaultItemError).item)
+}
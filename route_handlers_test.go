@@ -0,0 +1,95 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_RouteHandlers(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		manifests     []reply.ErrorManifest
+		options       []reply.Option
+		handler       func(replier *reply.Replier) http.Handler
+		method        string
+		expectedCode  int
+		expectedBody  string
+		expectedAllow []string
+	}{
+		{
+			name:         "Success - Not found default item",
+			manifests:    getEmptyErrorManifest(),
+			handler:      func(replier *reply.Replier) http.Handler { return replier.NotFoundHandler() },
+			method:       http.MethodGet,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Not Found","status":"404"}]}`,
+		},
+		{
+			name: "Success - Not found using configured key",
+			manifests: []reply.ErrorManifest{
+				{"route-not-found": reply.ErrorManifestItem{Title: "Route Not Found", Code: "R404", StatusCode: http.StatusNotFound}},
+			},
+			options:      []reply.Option{reply.WithNotFoundManifestKey("route-not-found")},
+			handler:      func(replier *reply.Replier) http.Handler { return replier.NotFoundHandler() },
+			method:       http.MethodGet,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Route Not Found","status":"404","code":"R404"}]}`,
+		},
+		{
+			name:         "Success - Not found without body for HEAD request",
+			manifests:    getEmptyErrorManifest(),
+			handler:      func(replier *reply.Replier) http.Handler { return replier.NotFoundHandler() },
+			method:       http.MethodHead,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:          "Success - Method not allowed without allowed methods",
+			manifests:     getEmptyErrorManifest(),
+			handler:       func(replier *reply.Replier) http.Handler { return replier.MethodNotAllowedHandler() },
+			method:        http.MethodPost,
+			expectedCode:  http.StatusMethodNotAllowed,
+			expectedBody:  `{"errors":[{"title":"Method Not Allowed","status":"405"}]}`,
+			expectedAllow: nil,
+		},
+		{
+			name: "Success - Method not allowed using configured key",
+			manifests: []reply.ErrorManifest{
+				{"route-method-not-allowed": reply.ErrorManifestItem{Title: "Unsupported Method", Code: "R405", StatusCode: http.StatusMethodNotAllowed}},
+			},
+			options: []reply.Option{reply.WithMethodNotAllowedManifestKey("route-method-not-allowed")},
+			handler: func(replier *reply.Replier) http.Handler {
+				return replier.MethodNotAllowedHandler(http.MethodGet, http.MethodPut)
+			},
+			method:        http.MethodPost,
+			expectedCode:  http.StatusMethodNotAllowed,
+			expectedBody:  `{"errors":[{"title":"Unsupported Method","status":"405","code":"R405"}]}`,
+			expectedAllow: []string{"GET, PUT"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(test.manifests, test.options...)
+
+			test.handler(replier).ServeHTTP(w, httptest.NewRequest(test.method, "/unknown", nil))
+
+			assert.Equal(t, test.expectedCode, w.Code)
+			assert.Equal(t, test.expectedAllow, w.Header().Values("Allow"))
+			if test.expectedBody == "" {
+				assert.Empty(t, w.Body.String())
+				return
+			}
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// Sanitizer is used to scrub the resolved manifest item of every error before
+// it is converted into a transfer object error. The status code passed is the
+// status code of the resolved manifest item.
+type Sanitizer func(item ErrorManifestItem, statusCode int) ErrorManifestItem
+
+// WithSanitizer sets the sanitizer used to scrub resolved manifest items before
+// they are returned in a response
+//
+// NOTE - The sanitizer is applied before any debug information is added
+func WithSanitizer(sanitizer Sanitizer) Option {
+	return func(r *Replier) {
+		r.sanitizer = sanitizer
+	}
+}
+
+// SanitizeServerErrors returns a sanitizer that removes the detail, about link
+// and meta from 5xx errors, leaving only their title, status and code
+func SanitizeServerErrors() Sanitizer {
+	return func(item ErrorManifestItem, statusCode int) ErrorManifestItem {
+		if !is5xx(statusCode) {
+			return item
+		}
+
+		item.Detail = ""
+		item.About = ""
+		item.Meta = nil
+
+		return item
+	}
+}
+
+// sanitizeErrorManifestItem returns the manifest item scrubbed by the replier's
+// sanitizer, if set
+func (r *Replier) sanitizeErrorManifestItem(item ErrorManifestItem) ErrorManifestItem {
+	if r.sanitizer == nil {
+		return item
+	}
+
+	return r.sanitizer(item, item.StatusCode)
+}
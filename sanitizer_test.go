@@ -0,0 +1,64 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithSanitizer(t *testing.T) {
+
+	manifests := append(getDefaultErrorManifest(), reply.ErrorManifest{
+		"example-db-error": reply.ErrorManifestItem{Title: "Database Error", Detail: "pq: relation users does not exist", About: "www.example.com/internal", StatusCode: http.StatusInternalServerError, Code: "DB01", Meta: "host=10.0.0.1"},
+	})
+
+	tests := []struct {
+		name         string
+		sanitizer    reply.Sanitizer
+		errs         []error
+		expectedBody string
+	}{
+		{
+			name:         "Success - Server error scrubbed",
+			sanitizer:    reply.SanitizeServerErrors(),
+			errs:         []error{errors.New("example-db-error")},
+			expectedBody: `{"errors":[{"title":"Database Error","status":"500","code":"DB01"}]}`,
+		},
+		{
+			name:         "Success - Client errors untouched",
+			sanitizer:    reply.SanitizeServerErrors(),
+			errs:         getMultiErrors(),
+			expectedBody: getMultiErrorResponseMultiErrors(),
+		},
+		{
+			name: "Success - Custom sanitizer applied to every error",
+			sanitizer: func(item reply.ErrorManifestItem, statusCode int) reply.ErrorManifestItem {
+				item.About = ""
+				return item
+			},
+			errs:         []error{errors.New("example-name-validation-error")},
+			expectedBody: `{"errors":[{"title":"Validation Error","detail":"The name provided does not meet validation requirements","status":"400","code":"1011"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, reply.WithSanitizer(test.sanitizer))
+
+			err := replier.NewHTTPMultiErrorResponse(w, test.errs)
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
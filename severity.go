@@ -0,0 +1,57 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"log"
+	"strings"
+)
+
+// Severity describes how serious an error is, controlling whether its error
+// response is logged and reported
+type Severity string
+
+const (
+	// SeverityInfo errors are expected, i.e. a missing resource, and are
+	// neither logged nor reported
+	SeverityInfo Severity = "info"
+
+	// SeverityWarn errors are logged at warn level, but not reported
+	SeverityWarn Severity = "warn"
+
+	// SeverityError errors are logged at error level and reported
+	SeverityError Severity = "error"
+
+	// SeverityCritical errors are logged at critical level and reported
+	SeverityCritical Severity = "critical"
+)
+
+// isReportable returns whether errors of the severity should be passed to the
+// reporter
+func (s Severity) isReportable() bool {
+	return s == SeverityError || s == SeverityCritical
+}
+
+// logError logs the error based on the severity of its manifest item. Items
+// without a severity are only logged when the error has no manifest entry.
+func logError(err error, item ErrorManifestItem, matched bool) {
+
+	switch item.Severity {
+	case SeverityInfo:
+		return
+	case "":
+		if !matched {
+			log.Printf("reply/error-response: failed to find error manifest item for %v", err)
+		}
+		return
+	}
+
+	if !matched {
+		log.Printf("reply/error-response: [%s] failed to find error manifest item for %v", strings.ToUpper(string(item.Severity)), err)
+		return
+	}
+
+	log.Printf("reply/error-response: [%s] %v resolved to status %d", strings.ToUpper(string(item.Severity)), err, item.StatusCode)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ErrorSeverity(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		severity       reply.Severity
+		statusCode     int
+		expectedLog    string
+		expectedReport bool
+	}{
+		{
+			name:       "Success - Unset severity keeps default behaviour",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:           "Success - Unset severity reports 5xx",
+			statusCode:     http.StatusBadGateway,
+			expectedReport: true,
+		},
+		{
+			name:       "Success - Info neither logged nor reported",
+			severity:   reply.SeverityInfo,
+			statusCode: http.StatusBadGateway,
+		},
+		{
+			name:        "Success - Warn logged but not reported",
+			severity:    reply.SeverityWarn,
+			statusCode:  http.StatusBadGateway,
+			expectedLog: "reply/error-response: [WARN] example-error resolved to status 502\n",
+		},
+		{
+			name:           "Success - Error logged and reported",
+			severity:       reply.SeverityError,
+			statusCode:     http.StatusNotFound,
+			expectedLog:    "reply/error-response: [ERROR] example-error resolved to status 404\n",
+			expectedReport: true,
+		},
+		{
+			name:           "Success - Critical logged and reported",
+			severity:       reply.SeverityCritical,
+			statusCode:     http.StatusInternalServerError,
+			expectedLog:    "reply/error-response: [CRITICAL] example-error resolved to status 500\n",
+			expectedReport: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+			log.SetFlags(0)
+			defer func() {
+				log.SetOutput(os.Stderr)
+				log.SetFlags(log.LstdFlags)
+			}()
+
+			reported := false
+			replier := reply.NewReplier([]reply.ErrorManifest{
+				{"example-error": reply.ErrorManifestItem{Title: "Example", StatusCode: test.statusCode, Severity: test.severity}},
+			}, reply.WithErrorReporter(reply.ReporterFunc(func(report reply.ErrorReport) {
+				reported = true
+			})))
+
+			w := httptest.NewRecorder()
+			err := replier.NewHTTPErrorResponse(w, errors.New("example-error"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.statusCode, w.Code)
+			assert.Equal(t, test.expectedLog, logs.String())
+			assert.Equal(t, test.expectedReport, reported)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// signatureHeader is the header the response body's signature is sent with
+	signatureHeader = "X-Signature"
+
+	// digestHeader is the header the response body's SHA-256 digest is sent with
+	digestHeader = "Digest"
+)
+
+// Signer outlines the methods a signer must implement to sign response bodies
+type Signer interface {
+	// Sign returns the signature of the response body, sent as the response's
+	// `X-Signature` header
+	Sign(body []byte) (string, error)
+}
+
+// HMACSigner signs response bodies with HMAC-SHA256, encoding signatures as
+// base64
+type HMACSigner struct {
+	key []byte
+}
+
+// NewHMACSigner returns a signer using the passed secret key
+func NewHMACSigner(key []byte) *HMACSigner {
+	return &HMACSigner{key: key}
+}
+
+// Sign returns the base64 encoded HMAC-SHA256 signature of the body
+func (s *HMACSigner) Sign(body []byte) (string, error) {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(body)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Ed25519Signer signs response bodies with Ed25519, encoding signatures as
+// base64
+type Ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewEd25519Signer returns a signer using the passed private key
+func NewEd25519Signer(privateKey ed25519.PrivateKey) *Ed25519Signer {
+	return &Ed25519Signer{privateKey: privateKey}
+}
+
+// Sign returns the base64 encoded Ed25519 signature of the body
+func (s *Ed25519Signer) Sign(body []byte) (string, error) {
+	if len(s.privateKey) != ed25519.PrivateKeySize {
+		return "", fmt.Errorf("reply/signature: failed to sign body, invalid private key size %d", len(s.privateKey))
+	}
+
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, body)), nil
+}
+
+// WithSignature signs the generated response's body, sending the signature as
+// the `X-Signature` header and the body's SHA-256 digest as the `Digest` header,
+// so receivers, i.e. of webhook callbacks, can verify the payload's integrity.
+//
+// NOTE - Signed bodies are buffered until complete, so streamed and flushed
+// responses are sent in one write
+func WithSignature(signer Signer) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Signer = signer
+	}
+}
+
+// signResponseBody wraps the response's writer so the body is buffered,
+// returning a function that signs the whole body before writing it with its
+// signature headers, unless generating the response failed before a status
+// code was set.
+//
+// NOTE - If the body cannot be signed, it is sent without a signature and the
+// signing error is returned
+func signResponseBody(response *NewResponseRequest) func(err error) error {
+	writer := &signatureResponseWriter{ResponseWriter: response.Writer}
+	response.Writer = writer
+
+	return func(err error) error {
+		response.Writer = writer.ResponseWriter
+		if err != nil && writer.statusCode == 0 {
+			return nil
+		}
+
+		return writer.writeSignedBody(response.Signer)
+	}
+}
+
+// signatureResponseWriter wraps a http.ResponseWriter, buffering the status
+// code and body so the signature headers can be set for the whole body
+type signatureResponseWriter struct {
+	http.ResponseWriter
+	statusCode    int
+	body          bytes.Buffer
+	headerWritten bool
+}
+
+// WriteHeader records the status code to be written with the body's signature
+func (w *signatureResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+// Write buffers the body until it is signed
+func (w *signatureResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// writeSignedBody sets the buffered body's signature and digest headers before
+// writing the status code and body
+func (w *signatureResponseWriter) writeSignedBody(signer Signer) error {
+	body := w.body.Bytes()
+
+	digest := sha256.Sum256(body)
+	w.Header().Set(digestHeader, "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]))
+
+	signature, signErr := signer.Sign(body)
+	if signErr == nil {
+		w.Header().Set(signatureHeader, signature)
+	}
+
+	w.writeHeader()
+
+	if len(body) > 0 {
+		if _, err := w.ResponseWriter.Write(body); err != nil {
+			return fmt.Errorf("reply/signature: failed to write signed response body, %w", err)
+		}
+	}
+
+	if signErr != nil {
+		return fmt.Errorf("reply/signature: failed to sign response body, %w", signErr)
+	}
+
+	return nil
+}
+
+// writeHeader writes the recorded status code once
+func (w *signatureResponseWriter) writeHeader() {
+	if w.headerWritten {
+		return
+	}
+	w.headerWritten = true
+
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	w.ResponseWriter.WriteHeader(w.statusCode)
+}
+
+// Flush does nothing, as the body is only written once it has been signed
+func (w *signatureResponseWriter) Flush() {}
+
+// Unwrap returns the wrapped writer, used by `http.ResponseController`
+func (w *signatureResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
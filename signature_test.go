@@ -0,0 +1,140 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingSigner struct{}
+
+func (s failingSigner) Sign(body []byte) (string, error) {
+	return "", errors.New("signer unavailable")
+}
+
+func TestReplier_WithSignature(t *testing.T) {
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	hmacKey := []byte("webhook-secret")
+
+	tests := []struct {
+		name        string
+		signer      reply.Signer
+		method      string
+		expectedErr error
+		verify      func(t *testing.T, body []byte, signature string)
+	}{
+		{
+			name:   "Success - HMAC signature",
+			signer: reply.NewHMACSigner(hmacKey),
+			verify: func(t *testing.T, body []byte, signature string) {
+				mac := hmac.New(sha256.New, hmacKey)
+				mac.Write(body)
+				assert.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), signature)
+			},
+		},
+		{
+			name:   "Success - Ed25519 signature",
+			signer: reply.NewEd25519Signer(privateKey),
+			verify: func(t *testing.T, body []byte, signature string) {
+				decoded, err := base64.StdEncoding.DecodeString(signature)
+				assert.NoError(t, err)
+				assert.True(t, ed25519.Verify(publicKey, body, decoded))
+			},
+		},
+		{
+			name:        "Failure - Invalid Ed25519 private key",
+			signer:      reply.NewEd25519Signer(ed25519.PrivateKey("short")),
+			expectedErr: errors.New("reply/signature: failed to sign response body, reply/signature: failed to sign body, invalid private key size 5"),
+			verify: func(t *testing.T, body []byte, signature string) {
+				assert.Empty(t, signature)
+			},
+		},
+		{
+			name:        "Failure - Signer error",
+			signer:      failingSigner{},
+			expectedErr: errors.New("reply/signature: failed to sign response body, signer unavailable"),
+			verify: func(t *testing.T, body []byte, signature string) {
+				assert.Empty(t, signature)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier([]reply.ErrorManifest{})
+
+			err := replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser(), reply.WithSignature(test.signer))
+			if test.expectedErr != nil {
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			body := w.Body.Bytes()
+			digest := sha256.Sum256(body)
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+			assert.Equal(t, "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]), w.Header().Get("Digest"))
+			test.verify(t, body, w.Header().Get("X-Signature"))
+		})
+	}
+}
+
+func TestReplier_WithSignatureHeadRequest(t *testing.T) {
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(),
+		reply.WithMethod(http.MethodHead),
+		reply.WithSignature(reply.NewHMACSigner([]byte("webhook-secret"))),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.NotEmpty(t, w.Header().Get("X-Signature"))
+	assert.NotEmpty(t, w.Header().Get("Digest"))
+}
+
+func TestReplier_WithSignatureStreamedResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier([]reply.ErrorManifest{})
+
+	hmacKey := []byte("webhook-secret")
+
+	rows := make(chan interface{}, 3)
+	for i := 1; i <= 3; i++ {
+		rows <- map[string]int{"id": i}
+	}
+	close(rows)
+
+	err := replier.NewHTTPDataStreamResponse(w, http.StatusOK, rows, reply.WithSignature(reply.NewHMACSigner(hmacKey)))
+
+	body := w.Body.Bytes()
+	digest := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, hmacKey)
+	mac.Write(body)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, stringWithNewLine(`{"data":[{"id":1},{"id":2},{"id":3}]}`), w.Body.String())
+	assert.Equal(t, "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]), w.Header().Get("Digest"))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), w.Header().Get("X-Signature"))
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// ResponseSink outlines the minimal methods needed to send a response. It
+// allows frameworks not built on net/http, i.e. fasthttp, to use the replier's
+// manifests, transfer objects and aides, see NewSinkResponseWriter.
+type ResponseSink interface {
+	// SetHeader replaces the values of the response header
+	SetHeader(key string, values []string)
+
+	// SetStatusCode sets the response's status code
+	SetStatusCode(statusCode int)
+
+	// Write appends the bytes to the response body
+	Write(b []byte) (int, error)
+}
+
+// NewSinkResponseWriter returns an http.ResponseWriter sending responses to the
+// sink, to be passed to the replier's aides. Headers are sent to the sink
+// together with the status code.
+func NewSinkResponseWriter(sink ResponseSink) http.ResponseWriter {
+	return &sinkResponseWriter{sink: sink, header: http.Header{}}
+}
+
+// sinkResponseWriter is an http.ResponseWriter sending responses to a sink
+type sinkResponseWriter struct {
+	sink        ResponseSink
+	header      http.Header
+	wroteHeader bool
+}
+
+// Header returns the response headers
+func (w *sinkResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write sends the bytes to the sink, sending a `200` status code first if one
+// has not been sent
+func (w *sinkResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.sink.Write(b)
+}
+
+// WriteHeader sends the headers and status code to the sink, once
+func (w *sinkResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	for key, values := range w.header {
+		w.sink.SetHeader(key, values)
+	}
+
+	w.sink.SetStatusCode(statusCode)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSink is a response sink recording what it is sent
+type testSink struct {
+	headers    map[string][]string
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (s *testSink) SetHeader(key string, values []string) {
+	if s.headers == nil {
+		s.headers = make(map[string][]string)
+	}
+	s.headers[key] = values
+}
+
+func (s *testSink) SetStatusCode(statusCode int) {
+	s.statusCode = statusCode
+}
+
+func (s *testSink) Write(b []byte) (int, error) {
+	return s.body.Write(b)
+}
+
+func TestNewSinkResponseWriter(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		send         func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Success - Data response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser())
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: getDataResponseBody(),
+		},
+		{
+			name: "Success - Error response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sink := &testSink{}
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			err := test.send(replier, reply.NewSinkResponseWriter(sink))
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, sink.statusCode)
+			assert.Equal(t, []string{"application/json"}, sink.headers["Content-Type"])
+			assert.Equal(t, stringWithNewLine(test.expectedBody), sink.body.String())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// WithStatusClassTransferObjects registers the transfer objects used for responses
+// of the status class, i.e. a minimal body for 5xx responses and a rich
+// envelope for 2xx responses. Nil members use the replier's (or API version's)
+// transfer objects.
+//
+// NOTE - Transfer object errors are chosen using the status code of their
+// manifest item
+func WithStatusClassTransferObjects(class StatusClass, pair TransferObjectPair) Option {
+	return func(r *Replier) {
+		if r.statusClassTransferObjects == nil {
+			r.statusClassTransferObjects = make(map[StatusClass]TransferObjectPair)
+		}
+
+		r.statusClassTransferObjects[class] = pair
+	}
+}
+
+// getStatusClass returns the class of the status code
+func getStatusClass(statusCode int) StatusClass {
+	return StatusClass(statusCode / 100)
+}
+
+// selectTransferObjects sets the transfer objects used for the response, using
+// its API version and, for responses without errors, status class. Error
+// responses are assigned their status class's transfer object once their
+// status code is resolved.
+func (r *Replier) selectTransferObjects(response *NewResponseRequest) {
+	pair := r.getVersionedTransferObjects(response)
+
+	response.transferObjectError = pair.TransferObjectError
+
+	if !r.isErrorResponse(response) {
+		statusCode := response.StatusCode
+		if statusCode == 0 {
+			statusCode = defaultStatusCode
+		}
+
+		if classPair, ok := r.statusClassTransferObjects[getStatusClass(statusCode)]; ok && classPair.TransferObject != nil {
+			pair.TransferObject = classPair.TransferObject
+		}
+	}
+
+	r.useTransferObject(response, pair.TransferObject)
+}
+
+// applyErrorStatusClassTransferObject replaces the transfer object of an error
+// response with its status class's, copying over the attributes already set
+// on the response's transfer object.
+//
+// NOTE - Headers and meta providers are not re-applied, as they were already
+// applied to the writer and response
+func (r *Replier) applyErrorStatusClassTransferObject(response *NewResponseRequest, statusCode int) {
+	classPair, ok := r.statusClassTransferObjects[getStatusClass(statusCode)]
+	if !ok || classPair.TransferObject == nil {
+		return
+	}
+
+	r.useTransferObject(response, classPair.TransferObject)
+
+	r.setTransferObjectAttributes(response)
+	r.setLinks(response)
+}
+
+// getStatusClassTransferObjectError returns the transfer object error used for
+// errors with the status code
+func (r *Replier) getStatusClassTransferObjectError(statusCode int, response *NewResponseRequest) TransferObjectError {
+	if classPair, ok := r.statusClassTransferObjects[getStatusClass(statusCode)]; ok && classPair.TransferObjectError != nil {
+		return classPair.TransferObjectError
+	}
+
+	if response.transferObjectError == nil {
+		return r.getVersionedTransferObjects(response).TransferObjectError
+	}
+
+	return response.transferObjectError
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithStatusClassTransferObjects(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest(),
+		reply.WithStatusClassTransferObjects(reply.StatusClassServerError, reply.TransferObjectPair{TransferObject: &fooReplyTransferObject{}, TransferObjectError: &barError{}}),
+		reply.WithStatusClassTransferObjects(reply.StatusClassSuccess, reply.TransferObjectPair{TransferObject: &fooReplyTransferObject{}}),
+	)
+
+	tests := []struct {
+		name               string
+		send               func(w http.ResponseWriter) error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Success - Server error uses class transfer objects",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("unknown-error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBodyUsingCustomTOEAndTO(),
+		},
+		{
+			name: "Success - Client error uses replier transfer objects",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne())
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       getErrorResponseForExampleErrorOne(),
+		},
+		{
+			name: "Success - Data response uses class transfer object",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"bar":{"data":{"id":"some-id","name":"john doe"}}}`,
+		},
+		{
+			name: "Success - Non-2xx data response uses replier transfer object",
+			send: func(w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusMultipleChoices, getTestUser())
+			},
+			expectedStatusCode: http.StatusMultipleChoices,
+			expectedBody:       getDataResponseBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			err := test.send(w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_WithStatusClassTransferObjectsAttributesAppliedOnce(t *testing.T) {
+
+	providerCalls := 0
+
+	replier := reply.NewReplier(getDefaultErrorManifest(),
+		reply.WithCORS(reply.CORSPolicy{Origins: []string{"*"}}),
+		reply.WithDefaultMeta(func(ctx context.Context) (string, interface{}) {
+			providerCalls++
+			return "calls", providerCalls
+		}),
+		reply.WithStatusClassTransferObjects(reply.StatusClassServerError, reply.TransferObjectPair{TransferObject: reply.NewTypedTransferObject[interface{}]()}),
+	)
+
+	w := httptest.NewRecorder()
+
+	err := replier.NewHTTPErrorResponse(w, errors.New("unknown-error"),
+		reply.WithOrigin("https://example.com"),
+		reply.WithAddedHeaders(http.Header{"Link": {"</status>; rel=\"help\""}}),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, []string{"Origin"}, w.Header().Values("Vary"))
+	assert.Equal(t, []string{"</status>; rel=\"help\""}, w.Header().Values("Link"))
+	assert.Equal(t, 1, providerCalls)
+	assert.Contains(t, w.Body.String(), `"meta":{"calls":1}`)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"log"
+	"strings"
+)
+
+// StatusCodePolicy returns the status code that should be used for a manifest
+// item that has not set its own status code. A policy should return `0` if it
+// does not apply to the passed manifest item.
+type StatusCodePolicy func(key string, item ErrorManifestItem) int
+
+// WithDefaultErrorStatusCode sets the status code used for manifest items
+// that have not set their own status code, and no status code policy applies.
+//
+// NOTE - If the passed status code is not valid (100 - 599), a warning is
+// logged and `400` will be used
+func WithDefaultErrorStatusCode(statusCode int) Option {
+	return func(r *Replier) {
+		r.defaultErrorStatusCode = statusCode
+	}
+}
+
+// WithStatusCodePolicies sets the policies used to resolve the status code of
+// manifest items that have not set their own status code. Policies are
+// evaluated in the order passed, the first to return a non-zero status
+// code wins.
+func WithStatusCodePolicies(policies ...StatusCodePolicy) Option {
+	return func(r *Replier) {
+		r.statusCodePolicies = append(r.statusCodePolicies, policies...)
+	}
+}
+
+// StatusCodeForKeyPrefix returns a status code policy that applies the passed
+// status code to manifest items whose key starts with the prefix,
+// e.g. `validation/` -> `422`
+func StatusCodeForKeyPrefix(prefix string, statusCode int) StatusCodePolicy {
+	return func(key string, item ErrorManifestItem) int {
+		if strings.HasPrefix(key, prefix) {
+			return statusCode
+		}
+
+		return 0
+	}
+}
+
+// applyErrorStatusCodePolicies validates the replier's default error status code
+// and resolves the status code of every manifest item that has not set its
+// own. Manifest items with invalid status codes are logged and replaced.
+func (r *Replier) applyErrorStatusCodePolicies() {
+
+	if !isValidStatusCode(r.defaultErrorStatusCode) {
+		log.Printf("reply/manifest: invalid default error status code %d, using %d", r.defaultErrorStatusCode, defaultErrorsStatusCode)
+		r.defaultErrorStatusCode = defaultErrorsStatusCode
+	}
+
+	for key, item := range r.errorManifest {
+		r.resolveManifestItemStatusCode(key, &item)
+		r.errorManifest[key] = item
+	}
+
+	for i, regexpItem := range r.regexpErrorManifest {
+		r.resolveManifestItemStatusCode(regexpItem.Pattern.String(), &regexpItem.Item)
+		r.regexpErrorManifest[i] = regexpItem
+	}
+}
+
+// resolveManifestItemStatusCode replaces the manifest item's status code if it
+// is invalid (logging a warning), before setting its default status code
+func (r *Replier) resolveManifestItemStatusCode(key string, item *ErrorManifestItem) {
+	if item.StatusCode != 0 && !isValidStatusCode(item.StatusCode) {
+		log.Printf("reply/manifest: invalid status code %d for manifest item %q, resolving using policies", item.StatusCode, key)
+		item.StatusCode = 0
+	}
+
+	r.setDefaultStatusCode(key, item)
+}
+
+// setDefaultStatusCode sets the error manifest item's status code using the
+// replier's status code policies, or default error status code, if it is
+// not already set (non-zero)
+func (r *Replier) setDefaultStatusCode(key string, item *ErrorManifestItem) {
+	if item.StatusCode != 0 {
+		return
+	}
+
+	for _, policy := range r.statusCodePolicies {
+		statusCode := policy(key, *item)
+		if statusCode == 0 {
+			continue
+		}
+
+		if !isValidStatusCode(statusCode) {
+			log.Printf("reply/manifest: status code policy returned invalid status code %d for %q, ignoring", statusCode, key)
+			continue
+		}
+
+		item.StatusCode = statusCode
+		item.statusCodeDefaulted = true
+		return
+	}
+
+	item.StatusCode = r.defaultErrorStatusCode
+	item.statusCodeDefaulted = true
+}
+
+// isValidStatusCode returns whether the status code is within the range
+// of valid HTTP status codes
+func isValidStatusCode(statusCode int) bool {
+	return statusCode >= 100 && statusCode <= 599
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_DefaultErrorStatusCodePolicy(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"example-unset-error": reply.ErrorManifestItem{Title: "Unset Error"}},
+		{"validation/name": reply.ErrorManifestItem{Title: "Validation Error"}},
+		{"example-invalid-status-error": reply.ErrorManifestItem{Title: "Invalid Status Error", StatusCode: 42}},
+		{"example-404-error": reply.ErrorManifestItem{Title: "Resource Not Found", StatusCode: http.StatusNotFound}},
+	}
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		err                error
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success - Unset status code uses default (400)",
+			err:                errors.New("example-unset-error"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Unset status code uses configured default",
+			options:            []reply.Option{reply.WithDefaultErrorStatusCode(http.StatusConflict)},
+			err:                errors.New("example-unset-error"),
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Success - Invalid configured default falls back to 400",
+			options:            []reply.Option{reply.WithDefaultErrorStatusCode(0)},
+			err:                errors.New("example-unset-error"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success - Matching policy takes precedence over default",
+			options: []reply.Option{
+				reply.WithDefaultErrorStatusCode(http.StatusConflict),
+				reply.WithStatusCodePolicies(reply.StatusCodeForKeyPrefix("validation/", http.StatusUnprocessableEntity)),
+			},
+			err:                errors.New("validation/name"),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Success - Invalid manifest status code is replaced",
+			err:                errors.New("example-invalid-status-error"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success - Explicit manifest status code is kept",
+			options: []reply.Option{
+				reply.WithDefaultErrorStatusCode(http.StatusConflict),
+				reply.WithStatusCodePolicies(reply.StatusCodeForKeyPrefix("example", http.StatusTeapot)),
+			},
+			err:                errors.New("example-404-error"),
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+		})
+	}
+}
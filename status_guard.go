@@ -0,0 +1,72 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrResponseAlreadyWritten is returned, wrapped, when a response is requested
+// for a writer whose response has already been written.
+//
+// NOTE - Written responses can only be detected for writers wrapped with a
+// ResponseWriterRecorder, or another writer implementing ResponseWriterWritten
+// (i.e. those of negroni or gin). Plain `http.ResponseWriter`s do not expose
+// whether they have been written to, so responding twice through one still
+// results in a superfluous `WriteHeader` call
+var ErrResponseAlreadyWritten = errors.New("response already written")
+
+// ResponseWriterWritten outlines the method a writer (or a writer it wraps) can
+// implement to report whether its response has been written, see
+// ErrResponseAlreadyWritten
+type ResponseWriterWritten interface {
+	Written() bool
+}
+
+// InvalidStatusCodeError is returned when a response is requested with a status
+// code outside of the range 100 - 599
+type InvalidStatusCodeError struct {
+	StatusCode int
+}
+
+// Error returns the invalid status code
+func (e *InvalidStatusCodeError) Error() string {
+	return fmt.Sprintf("reply/http-response: failed to send response, invalid status code %d", e.StatusCode)
+}
+
+// checkResponseWritable returns an error if the response's status code is invalid,
+// or its writer's response has already been written
+func checkResponseWritable(response *NewResponseRequest) error {
+
+	if response.StatusCode != 0 && !isValidStatusCode(response.StatusCode) {
+		return &InvalidStatusCodeError{StatusCode: response.StatusCode}
+	}
+
+	if isResponseWritten(response.Writer) {
+		return fmt.Errorf("reply/http-response: failed to send response, %w", ErrResponseAlreadyWritten)
+	}
+
+	return nil
+}
+
+// isResponseWritten returns whether any writer in the writer's chain of wrapped
+// writers reports its response as written
+func isResponseWritten(w http.ResponseWriter) bool {
+	for w != nil {
+		if writtenWriter, ok := w.(ResponseWriterWritten); ok && writtenWriter.Written() {
+			return true
+		}
+
+		unwrapper, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return false
+		}
+		w = unwrapper.Unwrap()
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_InvalidStatusCode(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{
+			name:       "Failure - Below range",
+			statusCode: 99,
+		},
+		{
+			name:       "Failure - Above range",
+			statusCode: 600,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(getEmptyErrorManifest())
+
+			err := replier.NewHTTPDataResponse(w, test.statusCode, getTestUser())
+
+			var statusErr *reply.InvalidStatusCodeError
+			assert.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, test.statusCode, statusErr.StatusCode)
+			assert.False(t, w.Flushed)
+			assert.Empty(t, w.Body.String())
+		})
+	}
+}
+
+func TestReplier_AlreadyWrittenResponse(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		firstWrite   func(replier *reply.Replier, w http.ResponseWriter)
+		expectedBody string
+	}{
+		{
+			name: "Failure - Written by replier",
+			firstWrite: func(replier *reply.Replier, w http.ResponseWriter) {
+				_ = replier.NewHTTPBlankResponse(w, http.StatusAccepted)
+			},
+			expectedBody: stringWithNewLine(getBlankResponseBody()),
+		},
+		{
+			name: "Failure - Written by handler",
+			firstWrite: func(replier *reply.Replier, w http.ResponseWriter) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			w := reply.NewResponseWriterRecorder(rec)
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			test.firstWrite(replier, w)
+			err := replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+
+			assert.ErrorIs(t, err, reply.ErrResponseAlreadyWritten)
+			assert.Equal(t, "reply/http-response: failed to send response, response already written", err.Error())
+			assert.Equal(t, http.StatusAccepted, rec.Code)
+			assert.Equal(t, test.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+// writtenTrackingWriter mirrors the writers of frameworks that report
+// whether their response has been written
+type writtenTrackingWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *writtenTrackingWriter) WriteHeader(statusCode int) {
+	w.written = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *writtenTrackingWriter) Written() bool {
+	return w.written
+}
+
+func TestReplier_AlreadyWrittenFrameworkWriter(t *testing.T) {
+
+	rec := httptest.NewRecorder()
+	w := &writtenTrackingWriter{ResponseWriter: rec}
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	assert.NoError(t, replier.NewHTTPBlankResponse(w, http.StatusAccepted))
+	err := replier.NewHTTPErrorResponse(w, errors.New("example-404-error"))
+
+	assert.ErrorIs(t, err, reply.ErrResponseAlreadyWritten)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, stringWithNewLine(getBlankResponseBody()), rec.Body.String())
+}
+
+func TestResponseWriterRecorder_IgnoresSuperfluousWriteHeader(t *testing.T) {
+
+	rec := httptest.NewRecorder()
+	w := reply.NewResponseWriterRecorder(rec)
+
+	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(http.StatusInternalServerError)
+
+	assert.Equal(t, http.StatusCreated, w.StatusCode())
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
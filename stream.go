@@ -0,0 +1,268 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// StreamMode describes how the events of a streamed response are framed
+type StreamMode int
+
+const (
+	// StreamNDJSON writes each event's data as a line of newline delimited
+	// JSON, `application/x-ndjson`
+	StreamNDJSON StreamMode = iota
+
+	// StreamSSE writes each event as a server-sent event, `text/event-stream`
+	StreamSSE
+)
+
+const (
+	// streamErrorEventName is the name of the server-sent event written when a
+	// stream ends with an error
+	streamErrorEventName = "error"
+)
+
+// StreamEvent holds an event of a streamed response, see NewHTTPStreamResponse
+type StreamEvent struct {
+	// Data holds the value encoded as the event's data
+	Data interface{}
+
+	// Name holds the server-sent event's name, ignored for NDJSON streams.
+	// Must not contain line breaks
+	Name string
+
+	// ID holds the server-sent event's id, ignored for NDJSON streams. Must
+	// not contain line breaks
+	ID string
+
+	// Err ends the stream, with its manifest item written as a final error
+	// event. Data is ignored when set.
+	Err error
+}
+
+// WithStreamFlushInterval sets how often streamed responses are flushed to the
+// client while events are written. Flushes are batched, so fast producers do
+// not flush on every event.
+//
+// NOTE - Defaults to flushing after every event
+func WithStreamFlushInterval(d time.Duration) Option {
+	return func(r *Replier) {
+		r.streamFlushInterval = d
+	}
+}
+
+// NewHTTPStreamResponse this response aide is used to create a chunked response
+// fed by the events received from events, framed using the passed mode. Events
+// are read once the previous event is written, so slow clients apply
+// backpressure to the producer. The response is completed once events is
+// closed, or an event holding an error is received.
+//
+// An event holding an error ends the stream with a final error event, i.e.
+// `{"errors":[...]}` for NDJSON streams, or an `error` server-sent event. The
+// error's manifest item is resolved as it is for error responses.
+//
+// NOTE - The status code is written before the first event, so the response can
+// not be replaced with an error response. If the response's context is done,
+// the stream is ended and an error returned.
+func (r *Replier) NewHTTPStreamResponse(w http.ResponseWriter, statusCode int, mode StreamMode, events <-chan StreamEvent, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: statusCode,
+		Stream:     events,
+		StreamMode: mode,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	if isEmpty(request.ContentType) {
+		request.ContentType = getStreamContentType(mode)
+	}
+
+	if mode == StreamSSE {
+		setResponseHeader(&request, "Cache-Control", "no-cache")
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// getStreamContentType returns the content type of streams using the mode
+func getStreamContentType(mode StreamMode) string {
+	if mode == StreamSSE {
+		return "text/event-stream"
+	}
+
+	return "application/x-ndjson"
+}
+
+// generateStreamResponse writes the events of the response's stream as they
+// are received, until the stream is closed or ends with an error
+func (r *Replier) generateStreamResponse(response *NewResponseRequest) error {
+
+	ctx := getResponseContext(response)
+
+	writer := r.newStreamWriter(response.transferObject.GetWriter())
+	defer writer.stop()
+
+	writer.writer.WriteHeader(response.transferObject.GetStatusCode())
+
+	for {
+		var event StreamEvent
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("reply/http-response: stream interrupted, context done with %w", ctx.Err())
+		case <-writer.ticks():
+			writer.flush()
+			continue
+		case event, ok = <-response.Stream:
+		}
+
+		if !ok {
+			return nil
+		}
+
+		if event.Err != nil {
+			return r.writeStreamErrorEvent(writer, response, event.Err)
+		}
+
+		if err := r.writeStreamEvent(writer, response.StreamMode, event); err != nil {
+			return err
+		}
+	}
+}
+
+// writeStreamErrorEvent writes the final event of a stream ending with an error,
+// holding the transfer object errors of the error's manifest item
+func (r *Replier) writeStreamErrorEvent(writer *streamWriter, response *NewResponseRequest, err error) error {
+
+	manifestItem, _ := r.resolveSentErrorManifestItem(err, response)
+	transferObjectErrors := []TransferObjectError{r.buildTransferObjectError(err, manifestItem, response)}
+
+	response.transferObject.SetStatusCode(manifestItem.StatusCode)
+	response.transferObject.SetErrors(transferObjectErrors)
+	response.result.setErrorCodes(transferObjectErrors)
+
+	return r.writeStreamEvent(writer, response.StreamMode, StreamEvent{
+		Name: streamErrorEventName,
+		Data: getTransferObjectResponseBody(response.transferObject),
+	})
+}
+
+// writeStreamEvent encodes and writes the event using the stream's framing
+func (r *Replier) writeStreamEvent(writer *streamWriter, mode StreamMode, event StreamEvent) error {
+
+	data := getBuffer()
+	defer putBuffer(data)
+
+	if err := r.encodeDataStreamItem(data, event.Data); err != nil {
+		return err
+	}
+
+	if mode != StreamSSE {
+		data.WriteByte('\n')
+		return writer.write(data.Bytes())
+	}
+
+	if strings.ContainsAny(event.ID+event.Name, "\r\n") {
+		return errors.New("reply/http-response: failed to write stream event, id and name must not contain line breaks")
+	}
+
+	body := getBuffer()
+	defer putBuffer(body)
+
+	if !isEmpty(event.ID) {
+		body.WriteString("id: " + event.ID + "\n")
+	}
+
+	if !isEmpty(event.Name) {
+		body.WriteString("event: " + event.Name + "\n")
+	}
+
+	for _, line := range bytes.Split(data.Bytes(), []byte("\n")) {
+		body.WriteString("data: ")
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+	body.WriteByte('\n')
+
+	return writer.write(body.Bytes())
+}
+
+// streamWriter writes the chunks of a streamed response, flushing them to the
+// client at the replier's stream flush interval
+type streamWriter struct {
+	writer        http.ResponseWriter
+	flushInterval time.Duration
+	ticker        *time.Ticker
+	pending       bool
+}
+
+// newStreamWriter returns a stream writer for the writer, using the replier's
+// stream flush interval
+func (r *Replier) newStreamWriter(writer http.ResponseWriter) *streamWriter {
+	stream := &streamWriter{writer: writer, flushInterval: r.streamFlushInterval}
+
+	if stream.flushInterval > 0 {
+		stream.ticker = time.NewTicker(stream.flushInterval)
+	}
+
+	return stream
+}
+
+// write writes the chunk, flushing it straight away if a flush interval is not
+// set
+func (s *streamWriter) write(chunk []byte) error {
+	if _, err := s.writer.Write(chunk); err != nil {
+		return fmt.Errorf("reply/http-response: failed to write response with %v", err)
+	}
+	s.pending = true
+
+	if s.ticker == nil {
+		s.flush()
+	}
+
+	return nil
+}
+
+// flush flushes chunks written since the last flush
+func (s *streamWriter) flush() {
+	if !s.pending {
+		return
+	}
+
+	flushWriter(s.writer)
+	s.pending = false
+}
+
+// ticks returns the channel signalling when pending chunks should be flushed,
+// nil if chunks are flushed as they are written
+func (s *streamWriter) ticks() <-chan time.Time {
+	if s.ticker == nil {
+		return nil
+	}
+
+	return s.ticker.C
+}
+
+// stop flushes any pending chunks and stops the flush interval's ticker
+func (s *streamWriter) stop() {
+	s.flush()
+
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+}
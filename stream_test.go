@@ -0,0 +1,139 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPStreamResponse(t *testing.T) {
+
+	errExportFailed := errors.New("export failed")
+
+	tests := []struct {
+		name                string
+		options             []reply.Option
+		mode                reply.StreamMode
+		events              []reply.StreamEvent
+		expectedContentType string
+		expectedBody        string
+		expectedFlushed     bool
+		expectedErr         error
+	}{
+		{
+			name:                "Success - NDJSON stream",
+			mode:                reply.StreamNDJSON,
+			events:              []reply.StreamEvent{{Data: getTestUser()}, {Data: "done"}},
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        "{\"id\":\"some-id\",\"name\":\"john doe\"}\n\"done\"\n",
+			expectedFlushed:     true,
+		},
+		{
+			name:                "Success - NDJSON stream ends with error",
+			mode:                reply.StreamNDJSON,
+			events:              []reply.StreamEvent{{Data: "a"}, {Err: errExportFailed}, {Data: "b"}},
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        "\"a\"\n{\"errors\":[{\"title\":\"Export Failed\",\"status\":\"500\",\"code\":\"EXPORT-500\"}]}\n",
+			expectedFlushed:     true,
+		},
+		{
+			name:                "Success - SSE stream",
+			mode:                reply.StreamSSE,
+			events:              []reply.StreamEvent{{Data: "a"}, {Name: "progress", ID: "2", Data: map[string]int{"done": 50}}},
+			expectedContentType: "text/event-stream",
+			expectedBody:        "data: \"a\"\n\nid: 2\nevent: progress\ndata: {\"done\":50}\n\n",
+			expectedFlushed:     true,
+		},
+		{
+			name:                "Success - SSE stream ends with error",
+			mode:                reply.StreamSSE,
+			events:              []reply.StreamEvent{{Err: errExportFailed}},
+			expectedContentType: "text/event-stream",
+			expectedBody:        "event: error\ndata: {\"errors\":[{\"title\":\"Export Failed\",\"status\":\"500\",\"code\":\"EXPORT-500\"}]}\n\n",
+			expectedFlushed:     true,
+		},
+		{
+			name:                "Failure - SSE event id with line break",
+			mode:                reply.StreamSSE,
+			events:              []reply.StreamEvent{{Data: "a"}, {ID: "2\ndata: injected", Data: "b"}},
+			expectedContentType: "text/event-stream",
+			expectedBody:        "data: \"a\"\n\n",
+			expectedFlushed:     true,
+			expectedErr:         errors.New("reply/http-response: failed to write stream event, id and name must not contain line breaks"),
+		},
+		{
+			name:                "Failure - SSE event name with carriage return",
+			mode:                reply.StreamSSE,
+			events:              []reply.StreamEvent{{Name: "progress\rretry: 1", Data: "a"}},
+			expectedContentType: "text/event-stream",
+			expectedErr:         errors.New("reply/http-response: failed to write stream event, id and name must not contain line breaks"),
+		},
+		{
+			name:                "Success - Flushed at interval",
+			options:             []reply.Option{reply.WithStreamFlushInterval(time.Hour)},
+			mode:                reply.StreamNDJSON,
+			events:              []reply.StreamEvent{{Data: "a"}},
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        "\"a\"\n",
+			expectedFlushed:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier([]reply.ErrorManifest{
+				{errExportFailed.Error(): reply.ErrorManifestItem{Title: "Export Failed", StatusCode: http.StatusInternalServerError, Code: "EXPORT-500"}},
+			}, test.options...)
+
+			events := make(chan reply.StreamEvent, len(test.events))
+			for _, event := range test.events {
+				events <- event
+			}
+			close(events)
+
+			err := replier.NewHTTPStreamResponse(w, http.StatusOK, test.mode, events)
+			if test.expectedErr != nil {
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, test.expectedContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, test.expectedBody, w.Body.String())
+			assert.Equal(t, test.expectedFlushed, w.Flushed)
+		})
+	}
+}
+
+func TestReplier_NewHTTPStreamResponseInterrupted(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier(getEmptyErrorManifest())
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events := make(chan reply.StreamEvent)
+	go func() {
+		events <- reply.StreamEvent{Data: "a"}
+		cancel()
+	}()
+
+	err := replier.NewHTTPStreamResponse(w, http.StatusOK, reply.StreamSSE, events, reply.WithContext(ctx))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "data: \"a\"\n\n", w.Body.String())
+}
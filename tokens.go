@@ -0,0 +1,152 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+)
+
+const (
+	// accessTokenName is the name of the token held in TokenOne
+	accessTokenName = "access_token"
+
+	// refreshTokenName is the name of the token held in TokenTwo
+	refreshTokenName = "refresh_token"
+)
+
+// TransferObjectTokens outlines the optional method a transfer object can
+// implement to return tokens other than the access and refresh token, i.e.
+// `id_token` or `device_code`
+type TransferObjectTokens interface {
+	SetTokens(tokens map[string]string)
+}
+
+// NewHTTPTokensResponse this response aide is used to create response explicitly for
+// returning named tokens, i.e. `id_token`, alongside (or instead of) the access and
+// refresh tokens. Tokens are added to the top level of the response body.
+//
+// NOTE - If the transfer object does not implement TransferObjectTokens, only the
+// `access_token` and `refresh_token` tokens are returned
+func (r *Replier) NewHTTPTokensResponse(w http.ResponseWriter, statusCode int, tokens map[string]string, attributes ...ResponseAttributes) error {
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("reply/http-tokens-aide: failed %w", ErrNoTokens)
+	}
+
+	request := NewResponseRequest{
+		Writer:     w,
+		StatusCode: statusCode,
+		Tokens:     tokens,
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// hasTokens returns whether the response request holds any tokens
+func hasTokens(response *NewResponseRequest) bool {
+	return !isEmpty(response.TokenOne) || !isEmpty(response.TokenTwo) || len(response.Tokens) > 0
+}
+
+// getResponseTokens returns all of the response request's tokens by name
+func getResponseTokens(response *NewResponseRequest) map[string]string {
+	tokens := make(map[string]string, len(response.Tokens)+2)
+
+	for name, token := range response.Tokens {
+		tokens[name] = token
+	}
+
+	if !isEmpty(response.TokenOne) {
+		tokens[accessTokenName] = response.TokenOne
+	}
+
+	if !isEmpty(response.TokenTwo) {
+		tokens[refreshTokenName] = response.TokenTwo
+	}
+
+	return tokens
+}
+
+// setTransferObjectTokens adds the response request's tokens to the transfer
+// object. Transfer objects not implementing TransferObjectTokens only receive
+// the access and refresh tokens.
+func (r *Replier) setTransferObjectTokens(response *NewResponseRequest) {
+
+	if len(response.Tokens) == 0 {
+		response.transferObject.SetTokenOne(response.TokenOne)
+		response.transferObject.SetTokenTwo(response.TokenTwo)
+		return
+	}
+
+	tokens := getResponseTokens(response)
+
+	if transferObject, ok := response.transferObject.(TransferObjectTokens); ok {
+		transferObject.SetTokens(tokens)
+		return
+	}
+
+	response.transferObject.SetTokenOne(tokens[accessTokenName])
+	response.transferObject.SetTokenTwo(tokens[refreshTokenName])
+
+	for name := range tokens {
+		if name != accessTokenName && name != refreshTokenName {
+			log.Printf("reply/http-response: transfer object %T does not support named tokens, only access and refresh tokens returned", response.transferObject)
+			return
+		}
+	}
+}
+
+// SetTokens adds the named tokens to the transfer object, the access and
+// refresh tokens are assigned to TokenOne and TokenTwo
+func (t *defaultReplyTransferObject) SetTokens(tokens map[string]string) {
+	for name, token := range tokens {
+		switch name {
+		case accessTokenName:
+			t.TokenOne = token
+		case refreshTokenName:
+			t.TokenTwo = token
+		default:
+			if t.Tokens == nil {
+				t.Tokens = make(map[string]string, len(tokens))
+			}
+			t.Tokens[name] = token
+		}
+	}
+}
+
+// responseBody returns the value encoded as the response body. Named tokens can
+// not be rendered using struct tags, so the transfer object is rendered with the
+// default envelope keys when it holds them.
+func (t *defaultReplyTransferObject) responseBody() interface{} {
+	if len(t.Tokens) == 0 {
+		return t
+	}
+
+	return &keyedTransferObject{transferObject: t, keys: defaultEnvelopeKeys}
+}
+
+// getSortedTokenNames returns the names of the non-empty tokens in
+// alphabetical order
+func getSortedTokenNames(tokens map[string]string) []string {
+	names := make([]string, 0, len(tokens))
+
+	for name, token := range tokens {
+		if isEmpty(token) {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
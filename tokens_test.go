@@ -0,0 +1,75 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPTokensResponse(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		options      []reply.Option
+		tokens       map[string]string
+		attributes   []reply.ResponseAttributes
+		expectedErr  error
+		expectedBody string
+	}{
+		{
+			name:        "Failure - No tokens",
+			tokens:      map[string]string{},
+			expectedErr: errors.New("reply/http-tokens-aide: failed at least one token must be returned"),
+		},
+		{
+			name:         "Success - Access and refresh tokens",
+			tokens:       map[string]string{"access_token": "access", "refresh_token": "refresh"},
+			expectedBody: `{"access_token":"access","refresh_token":"refresh"}`,
+		},
+		{
+			name:         "Success - Named tokens with meta",
+			tokens:       map[string]string{"id_token": "id", "access_token": "access", "device_code": "device"},
+			attributes:   []reply.ResponseAttributes{reply.WithMeta(map[string]interface{}{"example": "meta in response"})},
+			expectedBody: `{"access_token":"access","device_code":"device","id_token":"id","meta":{"example":"meta in response"}}`,
+		},
+		{
+			name:         "Success - Named tokens with envelope keys",
+			options:      []reply.Option{reply.WithEnvelopeKeys(reply.EnvelopeKeys{AccessToken: "token"})},
+			tokens:       map[string]string{"id_token": "id", "access_token": "access"},
+			expectedBody: `{"token":"access","id_token":"id"}`,
+		},
+		{
+			name:         "Success - Unsupported transfer object only returns access and refresh tokens",
+			options:      []reply.Option{reply.WithTransferObject(&fooReplyTransferObject{})},
+			tokens:       map[string]string{"id_token": "id", "access_token": "access"},
+			expectedBody: `{"bar":{"access_token":"access"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+
+			err := replier.NewHTTPTokensResponse(w, http.StatusOK, test.tokens, test.attributes...)
+
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, reply.ErrNoTokens)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"sync"
+)
+
+// TransferObjectReset outlines the optional method a transfer object can
+// implement to be cleared and reused for later responses, instead of
+// allocating a fresh transfer object with RefreshTransferObject for every
+// response
+type TransferObjectReset interface {
+	Reset()
+}
+
+// transferObjectPools holds a pool of transfer objects for each of the
+// replier's transfer objects implementing TransferObjectReset
+type transferObjectPools struct {
+	pools sync.Map
+}
+
+// get returns an empty transfer object of the same kind as the passed one,
+// from its pool where it implements TransferObjectReset
+func (p *transferObjectPools) get(source TransferObject) TransferObject {
+	if _, ok := source.(TransferObjectReset); !ok {
+		return source.RefreshTransferObject()
+	}
+
+	pool, ok := p.pools.Load(source)
+	if !ok {
+		pool, _ = p.pools.LoadOrStore(source, &sync.Pool{
+			New: func() interface{} {
+				return source.RefreshTransferObject()
+			},
+		})
+	}
+
+	return pool.(*sync.Pool).Get().(TransferObject)
+}
+
+// put resets the transfer object and returns it to the pool of the transfer
+// object it was taken for
+func (p *transferObjectPools) put(source, transferObject TransferObject) {
+	resetter, ok := transferObject.(TransferObjectReset)
+	if !ok {
+		return
+	}
+
+	pool, ok := p.pools.Load(source)
+	if !ok {
+		return
+	}
+
+	resetter.Reset()
+	pool.(*sync.Pool).Put(transferObject)
+}
+
+// useTransferObject sets the transfer object used for the response, taken
+// for the passed transfer object, releasing any the response already holds
+func (r *Replier) useTransferObject(response *NewResponseRequest, source TransferObject) {
+	r.releaseTransferObject(response)
+
+	response.transferObjectSource = source
+	response.transferObject = r.transferObjectPools.get(source)
+}
+
+// releaseTransferObject returns the response's transfer object to its pool,
+// once the response has been written
+func (r *Replier) releaseTransferObject(response *NewResponseRequest) {
+	if response.transferObject == nil {
+		return
+	}
+
+	r.transferObjectPools.put(response.transferObjectSource, response.transferObject)
+	response.transferObject = nil
+	response.transferObjectSource = nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_ConcurrentResponses(t *testing.T) {
+
+	transferObject := reply.NewTypedTransferObject[interface{}]()
+
+	tests := []struct {
+		name    string
+		options []reply.Option
+	}{
+		{
+			name: "Success - Default transfer object",
+		},
+		{
+			name:    "Success - Passed transfer object",
+			options: []reply.Option{reply.WithTransferObject(transferObject)},
+		},
+		{
+			name: "Success - Status class transfer objects",
+			options: []reply.Option{reply.WithStatusClassTransferObjects(reply.StatusClassClientError, reply.TransferObjectPair{
+				TransferObject: reply.NewTypedTransferObject[interface{}](),
+			})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					w := httptest.NewRecorder()
+					name := fmt.Sprintf("user-%d", i)
+
+					if i%2 == 0 {
+						assert.NoError(t, replier.NewHTTPErrorResponse(w, errors.New("example-404-error"), reply.WithMeta(map[string]interface{}{"name": name})))
+						assert.Equal(t, http.StatusNotFound, w.Code)
+						assert.Equal(t, stringWithNewLine(`{"errors":[{"title":"Resource Not Found","status":"404"}],"meta":{"name":"`+name+`"}}`), w.Body.String())
+						return
+					}
+
+					assert.NoError(t, replier.NewHTTPDataResponse(w, http.StatusCreated, map[string]string{"name": name}))
+					assert.Equal(t, http.StatusCreated, w.Code)
+					assert.Equal(t, stringWithNewLine(`{"data":{"name":"`+name+`"}}`), w.Body.String())
+				}(i)
+			}
+			wg.Wait()
+
+			assert.Equal(t, reply.NewTypedTransferObject[interface{}](), transferObject)
+		})
+	}
+}
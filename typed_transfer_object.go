@@ -0,0 +1,105 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"net/http"
+)
+
+// TypedTransferObject is a transfer object whose data is held as T, so custom
+// envelopes built on top of it, or responses decoded into it, keep the type
+// of their data. It renders the same body as the default transfer object.
+//
+// NOTE - Data that is not a T, i.e. the `"{}"` data of blank responses, is
+// left as the zero value of T
+type TypedTransferObject[T any] struct {
+	HTTPWriter http.ResponseWriter    `json:"-"`
+	Headers    map[string]string      `json:"-"`
+	StatusCode int                    `json:"-"`
+	Errors     []TransferObjectError  `json:"errors,omitempty"`
+	Data       T                      `json:"data,omitempty"`
+	Warnings   []TransferObjectError  `json:"warnings,omitempty"`
+	TokenOne   string                 `json:"access_token,omitempty"`
+	TokenTwo   string                 `json:"refresh_token,omitempty"`
+	Meta       map[string]interface{} `json:"meta,omitempty"`
+}
+
+// NewTypedTransferObject returns a transfer object holding its data as T, to
+// be passed to WithTransferObject
+func NewTypedTransferObject[T any]() *TypedTransferObject[T] {
+	return &TypedTransferObject[T]{}
+}
+
+// SetHeaders adds headers to transfer object
+func (t *TypedTransferObject[T]) SetHeaders(headers map[string]string) {
+	t.Headers = headers
+}
+
+// SetStatusCode adds status code to transfer object
+func (t *TypedTransferObject[T]) SetStatusCode(code int) {
+	t.StatusCode = code
+}
+
+// SetMeta adds meta property to transfer object
+func (t *TypedTransferObject[T]) SetMeta(meta map[string]interface{}) {
+	t.Meta = meta
+}
+
+// SetWriter adds writer to transfer object
+func (t *TypedTransferObject[T]) SetWriter(writer http.ResponseWriter) {
+	t.HTTPWriter = writer
+}
+
+// SetTokenOne sets token value to token one on transfer object
+func (t *TypedTransferObject[T]) SetTokenOne(token string) {
+	t.TokenOne = token
+}
+
+// SetTokenTwo sets token value to token two on transfer object
+func (t *TypedTransferObject[T]) SetTokenTwo(token string) {
+	t.TokenTwo = token
+}
+
+// GetWriter returns the writer assigned with the transfer object
+func (t *TypedTransferObject[T]) GetWriter() http.ResponseWriter {
+	return t.HTTPWriter
+}
+
+// GetStatusCode returns the status code assigned to the transfer object
+func (t *TypedTransferObject[T]) GetStatusCode() int {
+	return t.StatusCode
+}
+
+// SetData adds passed data to the transfer object, if it is a T
+func (t *TypedTransferObject[T]) SetData(data interface{}) {
+	typedData, _ := data.(T)
+
+	t.Data = typedData
+}
+
+// GetData returns the transfer object's data
+func (t *TypedTransferObject[T]) GetData() T {
+	return t.Data
+}
+
+// SetErrors assigns the passed transfer object errors to the transfer object
+func (t *TypedTransferObject[T]) SetErrors(transferObjectErrors []TransferObjectError) {
+	t.Errors = transferObjectErrors
+}
+
+// SetWarnings assigns the passed warnings to the transfer object
+func (t *TypedTransferObject[T]) SetWarnings(warnings []TransferObjectError) {
+	t.Warnings = warnings
+}
+
+// RefreshTransferObject returns an empty instance of transfer object
+func (t *TypedTransferObject[T]) RefreshTransferObject() TransferObject {
+	return &TypedTransferObject[T]{}
+}
+
+// Reset clears the transfer object so it can be reused for the next response
+func (t *TypedTransferObject[T]) Reset() {
+	*t = TypedTransferObject[T]{}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedTransferObject(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		send         func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedBody string
+	}{
+		{
+			name: "Success - Typed data",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return reply.Data(replier, w, http.StatusOK, &user{ID: "some-id", Name: "john doe"}, reply.WithMeta(map[string]interface{}{"region": "eu"}))
+			},
+			expectedBody: `{"data":{"id":"some-id","name":"john doe"},"meta":{"region":"eu"}}`,
+		},
+		{
+			name: "Success - Data of another type left out",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, "john doe")
+			},
+			expectedBody: `{}`,
+		},
+		{
+			name: "Success - Errors",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne())
+			},
+			expectedBody: getErrorResponseForExampleErrorOne(),
+		},
+		{
+			name: "Success - Tokens",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPTokenResponse(w, http.StatusOK, "08a38541c4b01d2d", "")
+			},
+			expectedBody: `{"access_token":"08a38541c4b01d2d"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithTransferObject(reply.NewTypedTransferObject[*user]()))
+
+			assert.NoError(t, test.send(replier, w))
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestTypedTransferObject_SetData(t *testing.T) {
+
+	transferObject := reply.NewTypedTransferObject[[]string]()
+
+	transferObject.SetData([]string{"a", "b"})
+	assert.Equal(t, []string{"a", "b"}, transferObject.GetData())
+
+	transferObject.SetData(1)
+	assert.Nil(t, transferObject.GetData())
+
+	refreshed := transferObject.RefreshTransferObject()
+	assert.IsType(t, &reply.TypedTransferObject[[]string]{}, refreshed)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+)
+
+// UnwrapPolicy describes which error of a wrapped error chain (i.e. built with
+// `fmt.Errorf("...: %w", err)`) is matched to a manifest entry first
+type UnwrapPolicy int
+
+const (
+	// UnwrapOutermostFirst matches the passed error first, followed by each
+	// error it wraps, from the outermost to the innermost
+	UnwrapOutermostFirst UnwrapPolicy = iota
+
+	// UnwrapInnermostFirst matches the innermost wrapped error first, i.e. a
+	// sentinel error, followed by each error wrapping it up to the passed error
+	UnwrapInnermostFirst
+
+	// UnwrapNone only matches the passed error
+	UnwrapNone
+)
+
+// WithUnwrapPolicy sets which error of a wrapped error chain is matched to a
+// manifest entry first, when resolving an error's manifest item
+//
+// NOTE - Defaults to UnwrapOutermostFirst. Errors wrapping multiple errors (i.e.
+// built with `errors.Join`) are walked depth first.
+func WithUnwrapPolicy(policy UnwrapPolicy) Option {
+	return func(r *Replier) {
+		r.unwrapPolicy = policy
+	}
+}
+
+// lookupErrorChainManifestItem returns the manifest item of the first error in the
+// error's chain, ordered using the replier's unwrap policy, that has a manifest
+// entry, and whether one was found
+func (r *Replier) lookupErrorChainManifestItem(err error, response *NewResponseRequest) (ErrorManifestItem, bool) {
+
+	if r.unwrapPolicy == UnwrapNone {
+		return r.lookupResponseErrorManifestItem(err.Error(), response)
+	}
+
+	chain := getErrorChain(err)
+	if r.unwrapPolicy == UnwrapInnermostFirst {
+		for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+			chain[i], chain[j] = chain[j], chain[i]
+		}
+	}
+
+	for _, chainErr := range chain {
+		if manifestItem, ok := r.lookupResponseErrorManifestItem(chainErr.Error(), response); ok {
+			return manifestItem, true
+		}
+	}
+
+	return ErrorManifestItem{}, false
+}
+
+// getErrorChain returns the error followed by each error it wraps, depth first
+func getErrorChain(err error) []error {
+
+	chain := []error{}
+
+	for err != nil {
+		chain = append(chain, err)
+
+		if multiErr, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, wrappedErr := range multiErr.Unwrap() {
+				if wrappedErr != nil {
+					chain = append(chain, getErrorChain(wrappedErr)...)
+				}
+			}
+			break
+		}
+
+		err = errors.Unwrap(err)
+	}
+
+	return chain
+}
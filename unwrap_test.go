@@ -0,0 +1,100 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// joinedErrors wraps multiple errors, as built with `errors.Join`
+type joinedErrors []error
+
+// Error returns the number of wrapped errors
+func (e joinedErrors) Error() string {
+	return fmt.Sprintf("%d errors", len(e))
+}
+
+// Unwrap returns the wrapped errors
+func (e joinedErrors) Unwrap() []error {
+	return e
+}
+
+func TestReplier_UnwrapPolicy(t *testing.T) {
+
+	errUserNotFound := errors.New("user-not-found")
+	errStoreFailed := fmt.Errorf("store-failed: %w", errUserNotFound)
+
+	manifests := []reply.ErrorManifest{
+		{"user-not-found": reply.ErrorManifestItem{Title: "User Not Found", StatusCode: http.StatusNotFound}},
+		{errStoreFailed.Error(): reply.ErrorManifestItem{Title: "Store Failed", StatusCode: http.StatusServiceUnavailable}},
+	}
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		err                error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:               "Success - Wrapped sentinel matched",
+			err:                fmt.Errorf("handler: %w", fmt.Errorf("service: %w", errUserNotFound)),
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"User Not Found","status":"404"}]}`,
+		},
+		{
+			name:               "Success - Outermost match used by default",
+			err:                fmt.Errorf("handler: %w", errStoreFailed),
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedBody:       `{"errors":[{"title":"Store Failed","status":"503"}]}`,
+		},
+		{
+			name:               "Success - Innermost match used",
+			options:            []reply.Option{reply.WithUnwrapPolicy(reply.UnwrapInnermostFirst)},
+			err:                fmt.Errorf("handler: %w", errStoreFailed),
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"User Not Found","status":"404"}]}`,
+		},
+		{
+			name:               "Success - Joined errors walked",
+			err:                joinedErrors{errors.New("audit-failed"), fmt.Errorf("service: %w", errUserNotFound)},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"User Not Found","status":"404"}]}`,
+		},
+		{
+			name:               "Failure - Wrapped errors not matched without unwrapping",
+			options:            []reply.Option{reply.WithUnwrapPolicy(reply.UnwrapNone)},
+			err:                fmt.Errorf("handler: %w", errUserNotFound),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+		{
+			name:               "Failure - Unmatched chain uses fallback",
+			err:                fmt.Errorf("handler: %w", errors.New("unknown")),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := replier.NewHTTPErrorResponse(w, test.err)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+// WithUnwrappedData writes the data of data responses at the top level of the
+// response body, instead of nesting it under `data`. It eases migrating
+// existing APIs onto reply without breaking their clients.
+//
+// NOTE - Meta is not included in unwrapped data responses, error and token
+// responses are unaffected
+func WithUnwrappedData() Option {
+	return func(r *Replier) {
+		r.unwrappedData = true
+	}
+}
+
+// WithDataUnwrapped writes the response's data at the top level of the
+// response body, regardless of the replier's configuration, see WithUnwrappedData
+func WithDataUnwrapped() ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.UnwrappedData = true
+	}
+}
+
+// isDataUnwrapped returns whether the response's data should be written
+// without the transfer object envelope
+func (r *Replier) isDataUnwrapped(response *NewResponseRequest) bool {
+	return r.unwrappedData || response.UnwrappedData
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_UnwrappedData(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		send               func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:    "Success - Replier unwraps data",
+			options: []reply.Option{reply.WithUnwrappedData()},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser(), reply.WithMeta(map[string]interface{}{"example": "meta in response"}))
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"id":"some-id","name":"john doe"}`,
+		},
+		{
+			name: "Success - Response unwraps data",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, []string{"a", "b"}, reply.WithDataUnwrapped())
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `["a","b"]`,
+		},
+		{
+			name:    "Success - Errors keep envelope",
+			options: []reply.Option{reply.WithUnwrappedData()},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, getExampleErrorOne())
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       getErrorResponseForExampleErrorOne(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			replier := reply.NewReplier(getDefaultErrorManifest(), test.options...)
+
+			err := test.send(replier, w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	// validationErrorTitle is the title used for field errors that do not
+	// have a corresponding manifest entry
+	validationErrorTitle = "Validation Error"
+
+	// queryParameterPrefix is the prefix used on field error keys to
+	// reference a query parameter rather than a JSON field
+	queryParameterPrefix = "?"
+
+	// fieldErrorsMetaKey is the meta key holding field errors for transfer
+	// object errors that do not implement TransferObjectErrorFields
+	fieldErrorsMetaKey = "fields"
+)
+
+// TransferObjectErrorSource outlines the optional method a transfer object error
+// can implement to reference the part of the request that caused the error
+type TransferObjectErrorSource interface {
+	SetSource(pointer, parameter string)
+}
+
+// errorWithSource wraps an error with the part of the request that caused it
+type errorWithSource struct {
+	err       error
+	pointer   string
+	parameter string
+}
+
+// Error returns the wrapped error's value, so it can still be used to
+// look up its manifest item
+func (e *errorWithSource) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *errorWithSource) Unwrap() error {
+	return e.err
+}
+
+// ErrorWithSource wraps the passed error with the JSON pointer (i.e. `/data/name`)
+// and/ or query parameter that caused it. The source is added to the error's
+// transfer object error if it implements TransferObjectErrorSource.
+func ErrorWithSource(err error, pointer, parameter string) error {
+	return &errorWithSource{err: err, pointer: pointer, parameter: parameter}
+}
+
+// fieldValidationError is used to represent a field error message that does
+// not have a corresponding manifest entry
+type fieldValidationError struct {
+	message string
+}
+
+// Error returns the field error's message
+func (e *fieldValidationError) Error() string {
+	return e.message
+}
+
+// ErrorManifestItem returns a validation manifest item with the field error's
+// message as its detail
+func (e *fieldValidationError) ErrorManifestItem() ErrorManifestItem {
+	return ErrorManifestItem{Title: validationErrorTitle, Detail: e.message, StatusCode: http.StatusUnprocessableEntity}
+}
+
+// setTransferObjectErrorSource sets the source of the error on the transfer object
+// error, if the error has a source and the transfer object error supports it
+func setTransferObjectErrorSource(transferObjectError TransferObjectError, err error) {
+
+	sourceTransferObjectError, ok := transferObjectError.(TransferObjectErrorSource)
+	if !ok {
+		return
+	}
+
+	var withSource *errorWithSource
+	if errors.As(err, &withSource) {
+		sourceTransferObjectError.SetSource(withSource.pointer, withSource.parameter)
+	}
+}
+
+// NewHTTPValidationErrorResponse this response aide is used to create
+// a validation (422) error response, where each error references the
+// field that caused it.
+//
+// Field errors are keyed on the field, with its value holding the field's
+// error messages. Keys prefixed with `?` reference a query parameter (i.e. `?page`),
+// otherwise they are treated as a JSON pointer (i.e. `/data/name`, `name`).
+//
+// With this aide, if desired, you can add additional attributes by using the
+// WithHeaders and/ or WithMeta optional response attributes.
+//
+// NOTE - Messages with a corresponding manifest entry use that entry, otherwise
+// a `422 - Validation Error` with the message as its detail is used. Errors are
+// ordered by field then message.
+func (r *Replier) NewHTTPValidationErrorResponse(w http.ResponseWriter, fieldErrors map[string][]string, attributes ...ResponseAttributes) error {
+
+	request := NewResponseRequest{
+		Writer: w,
+		Errors: r.convertFieldErrors(fieldErrors),
+	}
+
+	// Add attributes to response request
+	for _, attribute := range attributes {
+		attribute(&request)
+	}
+
+	return r.NewHTTPResponse(&request)
+}
+
+// convertFieldErrors converts the field errors into errors referencing their
+// source, ordered by field then message
+func (r *Replier) convertFieldErrors(fieldErrors map[string][]string) []error {
+
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	errs := []error{}
+
+	for _, field := range fields {
+		messages := append([]string{}, fieldErrors[field]...)
+		sort.Strings(messages)
+
+		pointer, parameter := getFieldSource(field)
+
+		for _, message := range messages {
+			var err error = &fieldValidationError{message: message}
+			if _, ok := r.lookupErrorManifestItem(message); ok {
+				err = errors.New(message)
+			}
+
+			errs = append(errs, ErrorWithSource(err, pointer, parameter))
+		}
+	}
+
+	return errs
+}
+
+// getFieldSource returns the JSON pointer or query parameter referenced by the
+// field error key
+func getFieldSource(field string) (pointer, parameter string) {
+	if strings.HasPrefix(field, queryParameterPrefix) {
+		return "", strings.TrimPrefix(field, queryParameterPrefix)
+	}
+
+	if !strings.HasPrefix(field, "/") {
+		field = "/" + field
+	}
+
+	return field, ""
+}
+
+// TransferObjectErrorFields outlines the optional method a transfer object error
+// can implement to hold the field errors added with WithFieldErrors
+type TransferObjectErrorFields interface {
+	SetFields(fields map[string]string)
+}
+
+// WithFieldErrors adds field errors, keyed on the field with its message as the
+// value, to every error in the generated response, so validation handlers can
+// augment a manifest error without shaping their own structure.
+//
+// NOTE - Field errors are added under the `fields` key of transfer object errors
+// implementing TransferObjectErrorFields, otherwise under the `fields` key of
+// the error's meta
+func WithFieldErrors(fields map[string]string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.FieldErrors = fields
+	}
+}
+
+// setTransferObjectErrorFields adds the response's field errors to the transfer
+// object error, or its meta if fields are not supported
+func setTransferObjectErrorFields(transferObjectError TransferObjectError, response *NewResponseRequest) {
+
+	if response == nil || len(response.FieldErrors) == 0 {
+		return
+	}
+
+	if fieldsTransferObjectError, ok := transferObjectError.(TransferObjectErrorFields); ok {
+		fieldsTransferObjectError.SetFields(response.FieldErrors)
+		return
+	}
+
+	transferObjectError.SetMeta(mergeErrorMeta(transferObjectError.GetMeta(), fieldErrorsMetaKey, response.FieldErrors))
+}
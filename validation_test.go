@@ -0,0 +1,127 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_NewHTTPValidationErrorResponseAide(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		manifests          []reply.ErrorManifest
+		fieldErrors        map[string][]string
+		transferObjecError reply.TransferObjectError
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:      "Success - Field errors reference their source in stable order",
+			manifests: getEmptyErrorManifest(),
+			fieldErrors: map[string][]string{
+				"?page":      {"must be a number"},
+				"/data/name": {"must not be empty", "is too long"},
+			},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"is too long","status":"422","source":{"pointer":"/data/name"}},{"title":"Validation Error","detail":"must not be empty","status":"422","source":{"pointer":"/data/name"}},{"title":"Validation Error","detail":"must be a number","status":"422","source":{"parameter":"page"}}]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Success - Message with manifest entry uses manifest item",
+			manifests:          getDefaultErrorManifest(),
+			fieldErrors:        map[string][]string{"name": {"example-name-validation-error"}},
+			expectedBody:       `{"errors":[{"title":"Validation Error","detail":"The name provided does not meet validation requirements","about":"www.example.com/reply/validation/1011","status":"400","code":"1011","source":{"pointer":"/name"}}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Success - Custom transfer object error without source support",
+			manifests:          getEmptyErrorManifest(),
+			fieldErrors:        map[string][]string{"name": {"must not be empty"}},
+			transferObjecError: &barError{},
+			expectedBody:       `{"errors":[{"title":"Validation Error","message":"must not be empty","more":{"status":"422"}}]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			options := []reply.Option{}
+			if test.transferObjecError != nil {
+				options = append(options, reply.WithTransferObjectError(test.transferObjecError))
+			}
+
+			replier := reply.NewReplier(test.manifests, options...)
+
+			err := replier.NewHTTPValidationErrorResponse(w, test.fieldErrors)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_ErrorWithSource(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	err := replier.NewHTTPErrorResponse(w, reply.ErrorWithSource(errors.New("example-dob-validation-error"), "/dob", ""))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, stringWithNewLine(`{"errors":[{"title":"Validation Error","detail":"Check your DoB, and try again.","status":"400","code":"100YT","source":{"pointer":"/dob"}}]}`), w.Body.String())
+}
+
+func TestReplier_WithFieldErrors(t *testing.T) {
+
+	errValidation := errors.New("validation failed")
+	fields := map[string]string{"name": "must not be empty", "email": "must be a valid email"}
+
+	tests := []struct {
+		name                string
+		transferObjectError reply.TransferObjectError
+		expectedBody        string
+	}{
+		{
+			name:         "Success - Fields added to default transfer object error",
+			expectedBody: `{"errors":[{"title":"Validation Failed","status":"422","meta":{"docs":"https://example.com"},"fields":{"email":"must be a valid email","name":"must not be empty"}}]}`,
+		},
+		{
+			name:                "Success - Fields added to meta of custom transfer object error",
+			transferObjectError: &barError{},
+			expectedBody:        `{"errors":[{"title":"Validation Failed","more":{"status":"422","meta":{"docs":"https://example.com","fields":{"email":"must be a valid email","name":"must not be empty"}}}}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			options := []reply.Option{}
+			if test.transferObjectError != nil {
+				options = append(options, reply.WithTransferObjectError(test.transferObjectError))
+			}
+
+			replier := reply.NewReplier([]reply.ErrorManifest{
+				{errValidation.Error(): reply.ErrorManifestItem{Title: "Validation Failed", StatusCode: http.StatusUnprocessableEntity, Meta: map[string]interface{}{"docs": "https://example.com"}}},
+			}, options...)
+
+			err := replier.NewHTTPErrorResponse(w, errValidation, reply.WithFieldErrors(fields))
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// apiVersionPattern matches API versions such as `v2` in paths and vendor
+// media types
+var apiVersionPattern = regexp.MustCompile(`^v\d+$`)
+
+// TransferObjectPair holds the transfer object and transfer object error used
+// to shape responses. Nil members use the replier's.
+type TransferObjectPair struct {
+	TransferObject      TransferObject
+	TransferObjectError TransferObjectError
+}
+
+// WithVersionedTransferObjects registers the transfer objects used for each API
+// version, so a single replier can serve legacy and current envelopes. The
+// version is chosen per response, see WithAPIVersion. Responses without a
+// registered version use the replier's transfer objects.
+func WithVersionedTransferObjects(versions map[string]TransferObjectPair) Option {
+	return func(r *Replier) {
+		if r.versionedTransferObjects == nil {
+			r.versionedTransferObjects = make(map[string]TransferObjectPair, len(versions))
+		}
+
+		for version, pair := range versions {
+			r.versionedTransferObjects[version] = pair
+		}
+	}
+}
+
+// WithAPIVersion sets the API version the response is shaped for, see
+// WithVersionedTransferObjects
+func WithAPIVersion(version string) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.APIVersion = version
+	}
+}
+
+// APIVersionFromHeader returns the API version held in the request's header,
+// i.e. `X-API-Version`
+func APIVersionFromHeader(request *http.Request, header string) string {
+	return strings.TrimSpace(request.Header.Get(header))
+}
+
+// APIVersionFromPath returns the first segment of the path that looks like an
+// API version, i.e. `v2` for `/api/v2/users`
+func APIVersionFromPath(path string) string {
+	for _, segment := range strings.Split(path, "/") {
+		if apiVersionPattern.MatchString(segment) {
+			return segment
+		}
+	}
+
+	return ""
+}
+
+// APIVersionFromMediaType returns the API version held in the media type, either
+// as a `version` parameter (`application/json; version=2`) or within a vendor
+// media type (`application/vnd.example.v2+json`)
+func APIVersionFromMediaType(mediaType string) string {
+	mediaType, parameters, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return ""
+	}
+
+	if version, ok := parameters["version"]; ok {
+		return version
+	}
+
+	subtype := mediaType[strings.Index(mediaType, "/")+1:]
+	subtype = strings.SplitN(subtype, "+", 2)[0]
+
+	for _, part := range strings.Split(subtype, ".") {
+		if apiVersionPattern.MatchString(part) {
+			return part
+		}
+	}
+
+	return ""
+}
+
+// setDefaultTransferObjects records the replier's transfer objects, used for
+// responses without a registered API version
+func (r *Replier) setDefaultTransferObjects() {
+	r.defaultTransferObjects = TransferObjectPair{
+		TransferObject:      r.transferObject,
+		TransferObjectError: r.transferObjectError,
+	}
+}
+
+// getVersionedTransferObjects returns the transfer objects registered for the
+// response's API version, or the replier's
+func (r *Replier) getVersionedTransferObjects(response *NewResponseRequest) TransferObjectPair {
+	pair := r.defaultTransferObjects
+
+	versionPair, ok := r.versionedTransferObjects[response.APIVersion]
+	if !ok {
+		return pair
+	}
+
+	if versionPair.TransferObject != nil {
+		pair.TransferObject = versionPair.TransferObject
+	}
+
+	if versionPair.TransferObjectError != nil {
+		pair.TransferObjectError = versionPair.TransferObjectError
+	}
+
+	return pair
+}
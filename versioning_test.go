@@ -0,0 +1,73 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithVersionedTransferObjects(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest(), reply.WithVersionedTransferObjects(map[string]reply.TransferObjectPair{
+		"v1": {TransferObject: &fooReplyTransferObject{}, TransferObjectError: &barError{}},
+		"v2": {TransferObjectError: &barError{}},
+	}))
+
+	tests := []struct {
+		name         string
+		version      string
+		expectedBody string
+	}{
+		{
+			name:         "Success - Registered version",
+			version:      "v1",
+			expectedBody: getErrorResponseForExampleErrorOneUsingCustomTOEAndTO(),
+		},
+		{
+			name:         "Success - Registered version uses replier transfer object",
+			version:      "v2",
+			expectedBody: getErrorResponseForExampleErrorOneUsingCustomTOE(),
+		},
+		{
+			name:         "Success - Unregistered version",
+			version:      "v3",
+			expectedBody: getErrorResponseForExampleErrorOne(),
+		},
+		{
+			name:         "Success - No version",
+			expectedBody: getErrorResponseForExampleErrorOne(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			err := replier.NewHTTPErrorResponse(w, getExampleErrorOne(), reply.WithAPIVersion(test.version))
+
+			assert.NoError(t, err)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestAPIVersionExtraction(t *testing.T) {
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v2/users", nil)
+	request.Header.Set("X-API-Version", " v3 ")
+
+	assert.Equal(t, "v3", reply.APIVersionFromHeader(request, "X-API-Version"))
+	assert.Equal(t, "v2", reply.APIVersionFromPath(request.URL.Path))
+	assert.Equal(t, "", reply.APIVersionFromPath("/api/users/v2x"))
+	assert.Equal(t, "v2", reply.APIVersionFromMediaType("application/vnd.example.v2+json"))
+	assert.Equal(t, "2", reply.APIVersionFromMediaType("application/json; version=2"))
+	assert.Equal(t, "", reply.APIVersionFromMediaType("application/json"))
+	assert.Equal(t, "", reply.APIVersionFromMediaType("invalid;;"))
+}
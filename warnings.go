@@ -0,0 +1,54 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+const (
+	// warningsMetaKey is the meta key holding warnings for transfer objects
+	// that do not implement TransferObjectWarnings
+	warningsMetaKey = "warnings"
+)
+
+// TransferObjectWarnings outlines the optional method a transfer object can
+// implement to hold the warnings added with WithWarnings
+type TransferObjectWarnings interface {
+	SetWarnings(warnings []TransferObjectError)
+}
+
+// WithWarnings adds non-fatal errors (i.e. deprecation notices or soft validation
+// messages) to a data response, without affecting its status code. Warnings are
+// resolved using the error manifest, like errors, and rendered in the envelope's
+// `warnings` section:
+//
+//	{"data":{...},"warnings":[{"title":"Deprecated Endpoint","code":"W001"}]}
+//
+// NOTE - Warnings are added under the `warnings` key of the transfer object's
+// meta if it does not implement TransferObjectWarnings, and are ignored by
+// responses without data
+func WithWarnings(warnings []error) ResponseAttributes {
+	return func(r *NewResponseRequest) {
+		r.Warnings = warnings
+	}
+}
+
+// setTransferObjectWarnings adds the response's warnings to the transfer object,
+// or its meta if warnings are not supported
+func (r *Replier) setTransferObjectWarnings(response *NewResponseRequest) {
+
+	if len(response.Warnings) == 0 {
+		return
+	}
+
+	warnings := make([]TransferObjectError, 0, len(response.Warnings))
+	for _, warning := range response.Warnings {
+		warnings = append(warnings, r.buildTransferObjectError(warning, r.getErrorManifestItem(warning, response), response))
+	}
+
+	if warningsTransferObject, ok := response.transferObject.(TransferObjectWarnings); ok {
+		warningsTransferObject.SetWarnings(warnings)
+		return
+	}
+
+	response.transferObject.SetMeta(mergeMeta(response.meta, map[string]interface{}{warningsMetaKey: warnings}))
+}
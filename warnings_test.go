@@ -0,0 +1,88 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplier_WithWarnings(t *testing.T) {
+
+	manifests := []reply.ErrorManifest{
+		{"deprecated-endpoint": reply.ErrorManifestItem{Title: "Deprecated Endpoint", Detail: "Use /v2/users instead", Code: "W001", StatusCode: http.StatusOK}},
+	}
+
+	warnings := []error{errors.New("deprecated-endpoint")}
+
+	tests := []struct {
+		name               string
+		options            []reply.Option
+		send               func(replier *reply.Replier, w http.ResponseWriter) error
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Success - Warnings sent alongside data",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithWarnings(warnings))
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"warnings":[{"title":"Deprecated Endpoint","detail":"Use /v2/users instead","status":"200","code":"W001"}]}`,
+		},
+		{
+			name:    "Success - Warnings sent using envelope key",
+			options: []reply.Option{reply.WithEnvelopeKeys(reply.EnvelopeKeys{Warnings: "notices"})},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusCreated, getTestUser(), reply.WithWarnings(warnings), reply.WithMeta(map[string]interface{}{"version": "v1"}))
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"data":{"id":"some-id","name":"john doe"},"notices":[{"title":"Deprecated Endpoint","detail":"Use /v2/users instead","status":"200","code":"W001"}],"meta":{"version":"v1"}}`,
+		},
+		{
+			name:    "Success - Warnings added to meta of transfer object without warnings",
+			options: []reply.Option{reply.WithTransferObject(&fooReplyTransferObject{})},
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser(), reply.WithWarnings(warnings))
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"bar":{"meta":{"warnings":[{"title":"Deprecated Endpoint","detail":"Use /v2/users instead","status":"200","code":"W001"}]},"data":{"id":"some-id","name":"john doe"}}}`,
+		},
+		{
+			name: "Success - Warnings sent alongside partial response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPPartialResponse(w, http.StatusMultiStatus, getTestUser(), []error{errors.New("example-unknown-error")}, reply.WithWarnings(warnings))
+			},
+			expectedStatusCode: http.StatusMultiStatus,
+			expectedBody:       `{"errors":[{"title":"Internal Server Error","status":"500"}],"data":{"id":"some-id","name":"john doe"},"warnings":[{"title":"Deprecated Endpoint","detail":"Use /v2/users instead","status":"200","code":"W001"}]}`,
+		},
+		{
+			name: "Success - Warnings ignored by error response",
+			send: func(replier *reply.Replier, w http.ResponseWriter) error {
+				return replier.NewHTTPErrorResponse(w, errors.New("example-unknown-error"), reply.WithWarnings(warnings))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       getErrorResponseISEBody(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			replier := reply.NewReplier(manifests, test.options...)
+
+			err := test.send(replier, w)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+)
+
+// WithWriteDeadline extends the write deadline of every response's connection to
+// the passed duration from when the response is generated, so large and
+// streamed responses are not cut short by the server's write timeout
+//
+// NOTE - Writers that do not support write deadlines (see
+// `http.ResponseController`) are written without extending the deadline
+func WithWriteDeadline(d time.Duration) Option {
+	return func(r *Replier) {
+		r.writeDeadline = d
+	}
+}
+
+// extendWriteDeadline extends the writer's write deadline by the replier's write
+// deadline duration, if set
+func (r *Replier) extendWriteDeadline(writer http.ResponseWriter) {
+	if r.writeDeadline <= 0 {
+		return
+	}
+
+	err := setWriteDeadline(writer, time.Now().Add(r.writeDeadline))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("reply/http-response: failed to extend write deadline with %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// deadlineResponseWriter records the write deadlines set on it
+type deadlineResponseWriter struct {
+	*httptest.ResponseRecorder
+	deadlines []time.Time
+}
+
+func (w *deadlineResponseWriter) SetWriteDeadline(deadline time.Time) error {
+	w.deadlines = append(w.deadlines, deadline)
+	return nil
+}
+
+func TestReplier_WithWriteDeadline(t *testing.T) {
+
+	tests := []struct {
+		name              string
+		options           []reply.Option
+		wrap              func(w http.ResponseWriter) http.ResponseWriter
+		expectedDeadlines int
+	}{
+		{
+			name: "Success - Deadline not extended by default",
+		},
+		{
+			name:              "Success - Deadline extended",
+			options:           []reply.Option{reply.WithWriteDeadline(time.Minute)},
+			expectedDeadlines: 1,
+		},
+		{
+			name:    "Success - Deadline extended through wrapped writer",
+			options: []reply.Option{reply.WithWriteDeadline(time.Minute)},
+			wrap: func(w http.ResponseWriter) http.ResponseWriter {
+				return reply.NewResponseWriterRecorder(w)
+			},
+			expectedDeadlines: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &deadlineResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+			var writer http.ResponseWriter = w
+			if test.wrap != nil {
+				writer = test.wrap(w)
+			}
+
+			replier := reply.NewReplier(getEmptyErrorManifest(), test.options...)
+			before := time.Now()
+
+			err := replier.NewHTTPDataResponse(writer, http.StatusOK, getTestUser())
+
+			assert.NoError(t, err)
+			assert.Len(t, w.deadlines, test.expectedDeadlines)
+			for _, deadline := range w.deadlines {
+				assert.False(t, deadline.Before(before.Add(time.Minute)))
+			}
+			assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+		})
+	}
+}
+
+func TestReplier_WithWriteDeadline_UnsupportedWriter(t *testing.T) {
+
+	w := httptest.NewRecorder()
+	replier := reply.NewReplier(getEmptyErrorManifest(), reply.WithWriteDeadline(time.Minute))
+
+	err := replier.NewHTTPDataResponse(w, http.StatusOK, getTestUser())
+
+	assert.NoError(t, err)
+	assert.Equal(t, stringWithNewLine(getDataResponseBody()), w.Body.String())
+}
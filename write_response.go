@@ -0,0 +1,66 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteResponse writes the body of the response described by the response request
+// to the writer, without requiring a http.ResponseWriter. It produces the same
+// envelope as NewHTTPResponse, so CLIs, batch jobs and tests can reuse the
+// replier's manifest driven formatting.
+//
+// NOTE - The response request's Writer is ignored, and the response's status
+// code and headers are discarded
+func (r *Replier) WriteResponse(w io.Writer, response *NewResponseRequest) error {
+
+	buffered, err := r.renderResponse(response)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(buffered.body.Bytes()); err != nil {
+		return fmt.Errorf("reply/write-response: failed to write response with %v", err)
+	}
+
+	return nil
+}
+
+// MarshalResponse returns the encoded body and status code of the response
+// described by the response request, without writing it. It allows reply
+// envelopes to be published to message queues, caches or webhooks.
+//
+// NOTE - The response request's Writer is ignored, and the response's headers
+// are discarded
+func (r *Replier) MarshalResponse(response *NewResponseRequest) ([]byte, int, error) {
+
+	buffered, err := r.renderResponse(response)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return buffered.body.Bytes(), buffered.statusCode, nil
+}
+
+// renderResponse generates the response described by the response request into a
+// buffered response writer, leaving the response request's writer unchanged
+func (r *Replier) renderResponse(response *NewResponseRequest) (*bufferedResponseWriter, error) {
+
+	writer := response.Writer
+	defer func() {
+		response.Writer = writer
+	}()
+
+	buffered := newBufferedResponseWriter()
+	response.Writer = buffered
+
+	if err := r.NewHTTPResponse(response); err != nil {
+		return nil, err
+	}
+
+	return buffered, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2021 by Leon Silcott <leon@boasi.io>. All rights reserved.
+// Use of this source code is governed under MIT License.
+// See the [LICENSE](https://github.com/ooaklee/reply/blob/master/LICENSE) for details.
+
+package reply_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ooaklee/reply"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingWriter is an io.Writer that always fails
+type failingWriter struct{}
+
+func (failingWriter) Write(b []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestReplier_WriteResponse(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		request      reply.NewResponseRequest
+		expectedBody string
+	}{
+		{
+			name:         "Success - Data response",
+			request:      reply.NewResponseRequest{Data: getTestUser(), StatusCode: http.StatusOK},
+			expectedBody: getDataResponseBody(),
+		},
+		{
+			name:         "Success - Error response",
+			request:      reply.NewResponseRequest{Error: errors.New("example-404-error")},
+			expectedBody: `{"errors":[{"title":"Resource Not Found","status":"404"}]}`,
+		},
+		{
+			name:         "Success - Multi error response",
+			request:      reply.NewResponseRequest{Errors: getMultiErrors()},
+			expectedBody: getMultiErrorResponseMultiErrors(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body bytes.Buffer
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			err := replier.WriteResponse(&body, &test.request)
+
+			assert.NoError(t, err)
+			assert.Nil(t, test.request.Writer)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), body.String())
+		})
+	}
+}
+
+func TestReplier_WriteResponse_WriteFailure(t *testing.T) {
+
+	replier := reply.NewReplier(getDefaultErrorManifest())
+
+	err := replier.WriteResponse(failingWriter{}, &reply.NewResponseRequest{Data: getTestUser()})
+
+	assert.EqualError(t, err, "reply/write-response: failed to write response with disk full")
+}
+
+func TestReplier_MarshalResponse(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		request            reply.NewResponseRequest
+		expectedStatusCode int
+		expectedBody       string
+		expectedEncodeErr  bool
+	}{
+		{
+			name:               "Success - Data response",
+			request:            reply.NewResponseRequest{Data: getTestUser(), StatusCode: http.StatusCreated},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       getDataResponseBody(),
+		},
+		{
+			name:               "Success - Default status code",
+			request:            reply.NewResponseRequest{},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       getBlankResponseBody(),
+		},
+		{
+			name:               "Success - Error response",
+			request:            reply.NewResponseRequest{Error: errors.New("example-404-error")},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"errors":[{"title":"Resource Not Found","status":"404"}]}`,
+		},
+		{
+			name:              "Failure - Unencodable data",
+			request:           reply.NewResponseRequest{Data: make(chan int)},
+			expectedEncodeErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replier := reply.NewReplier(getDefaultErrorManifest())
+
+			body, statusCode, err := replier.MarshalResponse(&test.request)
+
+			if test.expectedEncodeErr {
+				var encodeErr *reply.EncodeError
+				assert.ErrorAs(t, err, &encodeErr)
+				assert.Nil(t, body)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatusCode, statusCode)
+			assert.Equal(t, stringWithNewLine(test.expectedBody), string(body))
+		})
+	}
+}